@@ -0,0 +1,119 @@
+package zeros
+
+import (
+	"slices"
+	"sync"
+)
+
+// Policy determines how a Broadcast treats subscribers that are not ready
+// to receive a published value.
+type Policy int
+
+const (
+	// Block waits until every subscriber has received the value.
+	Block Policy = iota
+	// DropNewest discards the value for subscribers that are not ready.
+	DropNewest
+	// DropOldest discards the oldest buffered value of subscribers whose
+	// buffer is full to make room for the new value. Subscribers without
+	// a buffer are treated as with DropNewest.
+	DropOldest
+)
+
+// Broadcast is a zero-valueable publisher that delivers every published
+// value to every subscriber.
+//
+// Buffer and Policy must not be changed after first use.
+type Broadcast[T any] struct {
+	// Buffer is the buffer capacity of each subscriber channel.
+	Buffer int
+	// Policy determines how Publish treats slow subscribers.
+	Policy Policy
+
+	pub    sync.Mutex // serializes Publish so subscribers see one order
+	mu     sync.Mutex // guards subs and closed
+	subs   Map[*Chan[T], struct{}]
+	closed bool
+}
+
+// Subscribe returns a channel that receives published values and a
+// function that unsubscribes and closes the channel.
+// If the Broadcast is closed, the returned channel is closed.
+func (b *Broadcast[T]) Subscribe() (<-chan T, func()) {
+	s := new(Chan[T])
+	s.SetCap(b.Buffer)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.closed {
+		s.Close()
+		return s.Chan(), func() {}
+	}
+	b.subs.Set(s, struct{}{})
+	return s.Chan(), func() {
+		// Close first to release a Publish blocked on this subscriber.
+		s.Close()
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		b.subs.Delete(s)
+	}
+}
+
+// Publish sends v to every subscriber according to the Policy.
+// It panics if the Broadcast is closed.
+func (b *Broadcast[T]) Publish(v T) {
+	b.pub.Lock()
+	defer b.pub.Unlock()
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		panic("zeros: Publish on closed Broadcast")
+	}
+	subs := slices.Collect(b.subs.Keys())
+	b.mu.Unlock()
+
+	// Deliver without holding mu so that a slow subscriber cannot block
+	// Subscribe, Len, or Close. A subscriber closed meanwhile is skipped.
+	for _, s := range subs {
+		switch b.Policy {
+		case Block:
+			_ = s.CheckSend(v)
+		case DropNewest:
+			_, _ = s.trySend(v)
+		case DropOldest:
+			dropOldest(s, v)
+		}
+	}
+}
+
+// dropOldest sends v on s, discarding buffered values until it fits.
+func dropOldest[T any](s *Chan[T], v T) {
+	for {
+		if ok, err := s.trySend(v); ok || err != nil || s.Cap() == 0 {
+			return
+		}
+		s.TryRecv()
+	}
+}
+
+// Len returns the number of subscribers.
+func (b *Broadcast[T]) Len() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.subs.Len()
+}
+
+// Close closes every subscriber channel.
+// Calls after the first have no effect.
+func (b *Broadcast[T]) Close() {
+	b.mu.Lock()
+	b.closed = true
+	subs := slices.Collect(b.subs.Keys())
+	b.subs.Clear()
+	b.mu.Unlock()
+
+	// Closing a subscriber releases a Publish blocked on it.
+	for _, s := range subs {
+		s.Close()
+	}
+}
@@ -0,0 +1,81 @@
+//go:build go1.25
+
+package zeros
+
+import (
+	"testing"
+	"testing/synctest"
+)
+
+func TestBroadcastBlock(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var b Broadcast[int]
+
+		c, _ := b.Subscribe()
+
+		done := make(chan struct{})
+		go func() {
+			b.Publish(42)
+			close(done)
+		}()
+		synctest.Wait()
+
+		select {
+		case <-done:
+			t.Fatal("Publish() returned before subscriber received")
+		default:
+		}
+
+		if got := <-c; got != 42 {
+			t.Errorf("subscriber received %d, want 42", got)
+		}
+		<-done
+	})
+}
+
+func TestBroadcastCloseBlocked(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var b Broadcast[int]
+
+		c, _ := b.Subscribe()
+
+		done := make(chan struct{})
+		go func() {
+			b.Publish(42)
+			close(done)
+		}()
+		synctest.Wait()
+
+		b.Close()
+		<-done
+
+		if _, ok := <-c; ok {
+			t.Error("subscriber channel open after Close()")
+		}
+		if got := b.Len(); got != 0 {
+			t.Errorf("Broadcast[int].Len() = %d, want 0", got)
+		}
+	})
+}
+
+func TestBroadcastUnsubscribeBlocked(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var b Broadcast[int]
+
+		_, unsubscribe := b.Subscribe()
+
+		done := make(chan struct{})
+		go func() {
+			b.Publish(42)
+			close(done)
+		}()
+		synctest.Wait()
+
+		unsubscribe()
+		<-done
+
+		if got := b.Len(); got != 0 {
+			t.Errorf("Broadcast[int].Len() = %d, want 0", got)
+		}
+	})
+}
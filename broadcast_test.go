@@ -0,0 +1,113 @@
+package zeros
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestBroadcastZeroValue(t *testing.T) {
+	var b Broadcast[int]
+	b.Buffer = 1
+
+	c1, _ := b.Subscribe()
+	c2, _ := b.Subscribe()
+
+	b.Publish(42)
+
+	if got := <-c1; got != 42 {
+		t.Errorf("first subscriber received %d, want 42", got)
+	}
+	if got := <-c2; got != 42 {
+		t.Errorf("second subscriber received %d, want 42", got)
+	}
+}
+
+func TestBroadcastUnsubscribe(t *testing.T) {
+	var b Broadcast[int]
+
+	c, unsubscribe := b.Subscribe()
+	unsubscribe()
+	unsubscribe()
+
+	if _, ok := <-c; ok {
+		t.Error("receive after unsubscribe succeeded, want closed channel")
+	}
+	if got := b.Len(); got != 0 {
+		t.Errorf("Broadcast[int].Len() after unsubscribe = %d, want 0", got)
+	}
+
+	b.Publish(42) // must not block or panic
+}
+
+func TestBroadcastClose(t *testing.T) {
+	var b Broadcast[int]
+
+	c, _ := b.Subscribe()
+	b.Close()
+	b.Close()
+
+	if _, ok := <-c; ok {
+		t.Error("receive after Close() succeeded, want closed channel")
+	}
+
+	c, _ = b.Subscribe()
+	if _, ok := <-c; ok {
+		t.Error("Subscribe() after Close() returned open channel")
+	}
+}
+
+func TestBroadcastPublishClosed(t *testing.T) {
+	var b Broadcast[int]
+
+	b.Close()
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Broadcast[int].Publish() after Close() did not panic")
+		}
+	}()
+	b.Publish(42)
+}
+
+func TestBroadcastDropNewest(t *testing.T) {
+	b := Broadcast[int]{Buffer: 2, Policy: DropNewest}
+
+	c, unsubscribe := b.Subscribe()
+	for i := range 4 {
+		b.Publish(i)
+	}
+	unsubscribe()
+
+	var got []int
+	for v := range c {
+		got = append(got, v)
+	}
+	if want := []int{0, 1}; !slices.Equal(got, want) {
+		t.Errorf("DropNewest subscriber received %v, want %v", got, want)
+	}
+}
+
+func TestBroadcastDropOldest(t *testing.T) {
+	b := Broadcast[int]{Buffer: 2, Policy: DropOldest}
+
+	c, unsubscribe := b.Subscribe()
+	for i := range 4 {
+		b.Publish(i)
+	}
+	unsubscribe()
+
+	var got []int
+	for v := range c {
+		got = append(got, v)
+	}
+	if want := []int{2, 3}; !slices.Equal(got, want) {
+		t.Errorf("DropOldest subscriber received %v, want %v", got, want)
+	}
+}
+
+func TestBroadcastDropOldestUnbuffered(t *testing.T) {
+	b := Broadcast[int]{Policy: DropOldest}
+
+	b.Subscribe()
+	b.Publish(42) // must not block
+}
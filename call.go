@@ -0,0 +1,62 @@
+package zeros
+
+import "context"
+
+// Call is a zero-valueable request/reply channel for in-process servers
+// such as actors. Clients send requests with Call, and servers answer
+// them with Serve.
+type Call[Req, Resp any] struct {
+	reqs Chan[callRequest[Req, Resp]]
+}
+
+type callRequest[Req, Resp any] struct {
+	req   Req
+	reply chan callReply[Resp] // buffered so servers never block
+}
+
+type callReply[Resp any] struct {
+	resp Resp
+	err  error
+}
+
+// Call sends req to a server and waits for its response.
+// It returns ctx.Err() if ctx is done first and ErrClosed if Close has
+// been called.
+func (c *Call[Req, Resp]) Call(ctx context.Context, req Req) (Resp, error) {
+	var zero Resp
+	r := callRequest[Req, Resp]{req, make(chan callReply[Resp], 1)}
+	if err := c.reqs.SendCtx(ctx, r); err != nil {
+		return zero, err
+	}
+	select {
+	case reply := <-r.reply:
+		return reply.resp, reply.err
+	case <-ctx.Done():
+		return zero, ctx.Err()
+	}
+}
+
+// Serve answers requests with the results of f until ctx is done or
+// Close is called. It returns ctx.Err() if ctx is done and nil after Close.
+//
+// Serve may be called from multiple goroutines to answer requests
+// concurrently.
+func (c *Call[Req, Resp]) Serve(
+	ctx context.Context, f func(Req) (Resp, error),
+) error {
+	for {
+		r, err := c.reqs.RecvCtx(ctx)
+		if err == ErrClosed {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		resp, err := f(r.req)
+		r.reply <- callReply[Resp]{resp, err}
+	}
+}
+
+// Close stops servers once they finish their current request and makes
+// subsequent calls fail with ErrClosed.
+// Calls after the first have no effect.
+func (c *Call[Req, Resp]) Close() { c.reqs.Close() }
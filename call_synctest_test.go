@@ -0,0 +1,79 @@
+//go:build go1.25
+
+package zeros
+
+import (
+	"errors"
+	"strconv"
+	"sync"
+	"testing"
+	"testing/synctest"
+)
+
+func TestCall(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var c Call[int, string]
+		defer c.Close()
+
+		go c.Serve(t.Context(), func(v int) (string, error) {
+			return strconv.Itoa(v), nil
+		})
+
+		got, err := c.Call(t.Context(), 42)
+		if got != "42" || err != nil {
+			t.Errorf(
+				"Call[int, string].Call(42) = %q, %v, want %q, nil",
+				got, err, "42",
+			)
+		}
+	})
+}
+
+func TestCallError(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var c Call[int, int]
+		defer c.Close()
+		errOdd := errors.New("odd")
+
+		go c.Serve(t.Context(), func(v int) (int, error) {
+			if v%2 != 0 {
+				return 0, errOdd
+			}
+			return v / 2, nil
+		})
+
+		if _, err := c.Call(t.Context(), 3); err != errOdd {
+			t.Errorf("Call[int, int].Call(3) = %v, want %v", err, errOdd)
+		}
+	})
+}
+
+func TestCallConcurrent(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		type actor struct {
+			n   int
+			add Call[int, int]
+		}
+		var a actor
+		defer a.add.Close()
+
+		go a.add.Serve(t.Context(), func(v int) (int, error) {
+			a.n += v
+			return a.n, nil
+		})
+
+		var wg sync.WaitGroup
+		for range 10 {
+			wg.Go(func() {
+				if _, err := a.add.Call(t.Context(), 1); err != nil {
+					t.Errorf("Call[int, int].Call(1) = %v, want nil", err)
+				}
+			})
+		}
+		wg.Wait()
+
+		if got, _ := a.add.Call(t.Context(), 0); got != 10 {
+			t.Errorf("Call[int, int].Call(0) = %d, want 10", got)
+		}
+	})
+}
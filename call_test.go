@@ -0,0 +1,43 @@
+package zeros
+
+import (
+	"context"
+	"testing"
+)
+
+func TestCallClosed(t *testing.T) {
+	var c Call[int, int]
+
+	c.Close()
+
+	if _, err := c.Call(context.Background(), 1); err != ErrClosed {
+		t.Errorf(
+			"Call[int, int].Call() after Close() = %v, want %v",
+			err, ErrClosed,
+		)
+	}
+	serve := func(v int) (int, error) { return v, nil }
+	if err := c.Serve(context.Background(), serve); err != nil {
+		t.Errorf("Call[int, int].Serve() after Close() = %v, want nil", err)
+	}
+}
+
+func TestCallCanceled(t *testing.T) {
+	var c Call[int, int]
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := c.Call(ctx, 1); err != context.Canceled {
+		t.Errorf(
+			"Call[int, int].Call() with canceled context = %v, want %v",
+			err, context.Canceled,
+		)
+	}
+	serve := func(v int) (int, error) { return v, nil }
+	if err := c.Serve(ctx, serve); err != context.Canceled {
+		t.Errorf(
+			"Call[int, int].Serve() with canceled context = %v, want %v",
+			err, context.Canceled,
+		)
+	}
+}
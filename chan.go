@@ -1,13 +1,69 @@
 package zeros
 
+import (
+	"context"
+	"errors"
+	"iter"
+	"sync"
+	"time"
+)
+
+// ErrClosed is returned by operations on a closed channel.
+var ErrClosed = errors.New("zeros: channel closed")
+
 // Chan is a zero-valueable channel wrapper that auto-initializes on first use.
-type Chan[T any] struct{ once OnceValue[chan T] }
+//
+// The zero Chan is unbuffered. Call SetCap before first use to make it
+// buffered.
+//
+// Close is safe to call more than once and from multiple goroutines.
+// The underlying channel must not be closed directly.
+type Chan[T any] struct {
+	once   OnceValue[chanState[T]]
+	mu     sync.RWMutex // held for reading by sends that must not panic
+	closer sync.Once
+	err    error // set by CloseWithError; guarded by mu
+	timers timerPool
+}
+
+type chanState[T any] struct {
+	c    chan T
+	done chan struct{} // closed when Close is first called
+}
+
+func (c *Chan[T]) state() chanState[T] {
+	return c.once.Do(func() chanState[T] { return newChanState[T](0) })
+}
+
+func newChanState[T any](n int) chanState[T] {
+	return chanState[T]{make(chan T, n), make(chan struct{})}
+}
 
 // Chan returns the underlying channel.
-func (c *Chan[T]) Chan() chan T {
-	return c.once.Do(func() chan T { return make(chan T) })
+func (c *Chan[T]) Chan() chan T { return c.state().c }
+
+// SetCap initializes the channel with a buffer capacity of n.
+// It panics if n is negative or the channel has already been initialized.
+func (c *Chan[T]) SetCap(n int) {
+	if n < 0 {
+		panic("zeros: SetCap called with negative capacity")
+	}
+	var set bool
+	c.once.Do(func() chanState[T] {
+		set = true
+		return newChanState[T](n)
+	})
+	if !set {
+		panic("zeros: SetCap called after Chan was initialized")
+	}
 }
 
+// Len returns the number of values queued in the channel buffer.
+func (c *Chan[T]) Len() int { return len(c.Chan()) }
+
+// Cap returns the capacity of the channel buffer.
+func (c *Chan[T]) Cap() int { return cap(c.Chan()) }
+
 // Send sends a value on the channel, blocking until the value is sent.
 func (c *Chan[T]) Send(v T) { c.Chan() <- v }
 
@@ -15,6 +71,61 @@ func (c *Chan[T]) Send(v T) { c.Chan() <- v }
 // Returns the zero value if the channel is closed.
 func (c *Chan[T]) Recv() T { return <-c.Chan() }
 
+// CheckSend sends a value on the channel, blocking until the value is sent
+// or the channel is closed. Unlike Send, it returns ErrClosed instead of
+// panicking if the channel is closed.
+func (c *Chan[T]) CheckSend(v T) error {
+	_, err := send[T, struct{}](c, v, nil)
+	return err
+}
+
+// SendCtx sends a value on the channel, blocking until the value is sent
+// or ctx is done. It returns ctx.Err() if ctx is done before the value is
+// sent and ErrClosed if the channel is closed.
+func (c *Chan[T]) SendCtx(ctx context.Context, v T) error {
+	if sent, err := send(c, v, ctx.Done()); err != nil || sent {
+		return err
+	}
+	return ctx.Err()
+}
+
+// send sends v on c unless c is closed or stop is ready first.
+// It reports whether v was sent, returning ErrClosed if c is closed.
+func send[T, S any](c *Chan[T], v T, stop <-chan S) (bool, error) {
+	s := c.state()
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	select {
+	case <-s.done:
+		return false, ErrClosed
+	default:
+	}
+	select {
+	case s.c <- v:
+		return true, nil
+	case <-s.done:
+		return false, ErrClosed
+	case <-stop:
+		return false, nil
+	}
+}
+
+// RecvCtx receives a value from the channel, blocking until a value is
+// available or ctx is done. It returns ErrClosed if the channel is closed
+// and ctx.Err() if ctx is done before a value is received.
+func (c *Chan[T]) RecvCtx(ctx context.Context) (T, error) {
+	select {
+	case v, ok := <-c.Chan():
+		if !ok {
+			return v, ErrClosed
+		}
+		return v, nil
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	}
+}
+
 // CheckRecv receives a value from the channel with a status indicator.
 // The boolean return value indicates whether the channel is open.
 func (c *Chan[T]) CheckRecv() (T, bool) {
@@ -47,5 +158,182 @@ func (c *Chan[T]) TryRecv() (T, bool) {
 	}
 }
 
+// SendTimeout sends a value on the channel, waiting at most d for a
+// receiver.
+// If the value is sent, it returns true and nil.
+// If d elapses first, it returns false and nil.
+// If the channel is closed, it returns false and ErrClosed.
+// If d is not positive, SendTimeout does not wait, like TrySend.
+func (c *Chan[T]) SendTimeout(v T, d time.Duration) (bool, error) {
+	// Try first so that an expired timer cannot win over a ready send.
+	if sent, err := c.trySend(v); sent || err != nil || d <= 0 {
+		return sent, err
+	}
+	t := c.timers.get(d)
+	defer c.timers.put(t)
+	return send(c, v, t.C)
+}
+
+// RecvTimeout receives a value from the channel, waiting at most d for a
+// value to become available.
+// If a value is received, it returns the value, true, and nil.
+// If d elapses first, it returns the zero value, false, and nil.
+// If the channel is closed, it returns the zero value, false, and ErrClosed.
+// If d is not positive, RecvTimeout does not wait, like TryRecv.
+func (c *Chan[T]) RecvTimeout(d time.Duration) (T, bool, error) {
+	// Try first so that an expired timer cannot win over a ready value.
+	select {
+	case v, ok := <-c.Chan():
+		if !ok {
+			return v, false, ErrClosed
+		}
+		return v, true, nil
+	default:
+		if d <= 0 {
+			var zero T
+			return zero, false, nil
+		}
+	}
+	t := c.timers.get(d)
+	defer c.timers.put(t)
+	select {
+	case v, ok := <-c.Chan():
+		if !ok {
+			return v, false, ErrClosed
+		}
+		return v, true, nil
+	case <-t.C:
+		var zero T
+		return zero, false, nil
+	}
+}
+
+// RecvBatch receives up to n values from the channel.
+// It blocks until the first value is available, then collects further
+// values until n values are received, wait elapses, or the channel is
+// closed. Values that are ready are always taken, even once wait has
+// elapsed. It returns nil if the channel is closed before any value is
+// received. It panics if n is less than 1.
+func (c *Chan[T]) RecvBatch(n int, wait time.Duration) []T {
+	if n < 1 {
+		panic("zeros: RecvBatch called with n < 1")
+	}
+	v, ok := c.CheckRecv()
+	if !ok {
+		return nil
+	}
+	batch := []T{v}
+	if n == 1 {
+		return batch
+	}
+	var expired <-chan time.Time
+	if wait > 0 {
+		t := c.timers.get(wait)
+		defer c.timers.put(t)
+		expired = t.C
+	}
+	for len(batch) < n {
+		// Take ready values first so that an expired timer cannot cut
+		// the batch short.
+		select {
+		case v, ok := <-c.Chan():
+			if !ok {
+				return batch
+			}
+			batch = append(batch, v)
+			continue
+		default:
+		}
+		if expired == nil {
+			return batch
+		}
+		select {
+		case v, ok := <-c.Chan():
+			if !ok {
+				return batch
+			}
+			batch = append(batch, v)
+		case <-expired:
+			return batch
+		}
+	}
+	return batch
+}
+
+// All returns an iterator over values received from the channel.
+// Iteration stops when the channel is closed.
+func (c *Chan[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range c.Chan() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// SendAll sends every value from seq on the channel, blocking on each send.
+func (c *Chan[T]) SendAll(seq iter.Seq[T]) {
+	for v := range seq {
+		c.Send(v)
+	}
+}
+
+// trySend sends v on the channel if it can do so without blocking.
+// It reports whether v was sent, returning ErrClosed instead of panicking
+// if the channel is closed.
+func (c *Chan[T]) trySend(v T) (bool, error) {
+	s := c.state()
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	select {
+	case <-s.done:
+		return false, ErrClosed
+	default:
+	}
+	select {
+	case s.c <- v:
+		return true, nil
+	default:
+		return false, nil
+	}
+}
+
 // Close closes the underlying channel.
-func (c *Chan[T]) Close() { close(c.Chan()) }
+// Calls after the first have no effect.
+func (c *Chan[T]) Close() { c.CloseWithError(nil) }
+
+// CloseWithError closes the underlying channel and records err, which
+// receivers can retrieve with Err once they observe the channel closed.
+// CloseWithError(nil) is equivalent to Close.
+// Calls after the first close have no effect.
+func (c *Chan[T]) CloseWithError(err error) {
+	s := c.state()
+	c.closer.Do(func() {
+		// Wake pending sends in CheckSend and SendCtx, then wait for them
+		// to return before closing the channel out from under them.
+		close(s.done)
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		c.err = err
+		close(s.c)
+	})
+}
+
+// Err returns the error passed to CloseWithError.
+// It returns nil if the channel is open or was closed with Close.
+func (c *Chan[T]) Err() error {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.err
+}
+
+// Closed reports whether Close has been called.
+func (c *Chan[T]) Closed() bool {
+	select {
+	case <-c.state().done:
+		return true
+	default:
+		return false
+	}
+}
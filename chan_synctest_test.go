@@ -3,8 +3,12 @@
 package zeros
 
 import (
+	"context"
+	"slices"
+	"sync"
 	"testing"
 	"testing/synctest"
+	"time"
 )
 
 func TestChanZeroValue(t *testing.T) {
@@ -131,3 +135,226 @@ func TestChanChan(t *testing.T) {
 		}
 	})
 }
+
+func TestChanSendCtx(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var ch Chan[int]
+
+		go func() {
+			if err := ch.SendCtx(t.Context(), 42); err != nil {
+				t.Errorf("Chan[int].SendCtx(42) = %v, want nil", err)
+			}
+		}()
+
+		if got := ch.Recv(); got != 42 {
+			t.Errorf("Chan[int].Recv() = %d, want 42", got)
+		}
+	})
+}
+
+func TestChanSendCtxBlockedClose(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var ch Chan[int]
+		var err error
+
+		go func() { err = ch.SendCtx(t.Context(), 42) }()
+		synctest.Wait()
+
+		ch.Close()
+		synctest.Wait()
+
+		if err != ErrClosed {
+			t.Errorf(
+				"Chan[int].SendCtx(42) blocked across Close() = %v, want %v",
+				err, ErrClosed,
+			)
+		}
+	})
+}
+
+func TestChanRecvCtx(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var ch Chan[int]
+
+		go func() { ch.Send(42) }()
+
+		if got, err := ch.RecvCtx(t.Context()); err != nil || got != 42 {
+			t.Errorf("Chan[int].RecvCtx() = %d, %v, want 42, nil", got, err)
+		}
+	})
+}
+
+func TestChanRecvCtxDeadline(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var ch Chan[int]
+		ctx, cancel := context.WithTimeout(t.Context(), time.Second)
+		defer cancel()
+
+		if _, err := ch.RecvCtx(ctx); err != context.DeadlineExceeded {
+			t.Errorf(
+				"Chan[int].RecvCtx() past deadline = %v, want %v",
+				err, context.DeadlineExceeded,
+			)
+		}
+	})
+}
+
+func TestChanCheckSend(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var ch Chan[int]
+
+		go func() {
+			if err := ch.CheckSend(42); err != nil {
+				t.Errorf("Chan[int].CheckSend(42) = %v, want nil", err)
+			}
+		}()
+
+		if got := ch.Recv(); got != 42 {
+			t.Errorf("Chan[int].Recv() = %d, want 42", got)
+		}
+	})
+}
+
+func TestChanCheckSendBlockedClose(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var ch Chan[int]
+		var err error
+
+		go func() { err = ch.CheckSend(42) }()
+		synctest.Wait()
+
+		ch.Close()
+		synctest.Wait()
+
+		if err != ErrClosed {
+			t.Errorf(
+				"Chan[int].CheckSend(42) blocked across Close() = %v, want %v",
+				err, ErrClosed,
+			)
+		}
+	})
+}
+
+func TestChanCloseConcurrent(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var ch Chan[int]
+		var wg sync.WaitGroup
+
+		for range 10 {
+			wg.Go(ch.Close)
+		}
+		wg.Wait()
+
+		if !ch.Closed() {
+			t.Error("Chan[int].Closed() after Close() = false, want true")
+		}
+	})
+}
+
+func TestChanSendTimeout(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var ch Chan[int]
+
+		if ok, err := ch.SendTimeout(42, time.Second); ok || err != nil {
+			t.Errorf(
+				"Chan[int].SendTimeout(42, 1s) without receiver = %t, %v, "+
+					"want false, nil",
+				ok, err,
+			)
+		}
+
+		go func() {
+			time.Sleep(500 * time.Millisecond)
+			ch.Recv()
+		}()
+
+		if ok, err := ch.SendTimeout(42, time.Second); !ok || err != nil {
+			t.Errorf(
+				"Chan[int].SendTimeout(42, 1s) with receiver = %t, %v, "+
+					"want true, nil",
+				ok, err,
+			)
+		}
+	})
+}
+
+func TestChanSendTimeoutBlockedClose(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var ch Chan[int]
+		var err error
+
+		go func() { _, err = ch.SendTimeout(42, time.Hour) }()
+		synctest.Wait()
+
+		ch.Close()
+		synctest.Wait()
+
+		if err != ErrClosed {
+			t.Errorf(
+				"Chan[int].SendTimeout(42, 1h) blocked across Close() = %v, "+
+					"want %v",
+				err, ErrClosed,
+			)
+		}
+	})
+}
+
+func TestChanRecvTimeout(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var ch Chan[int]
+
+		if _, ok, err := ch.RecvTimeout(time.Second); ok || err != nil {
+			t.Errorf(
+				"Chan[int].RecvTimeout(1s) without sender = _, %v, %v, "+
+					"want false, nil",
+				ok, err,
+			)
+		}
+
+		go func() {
+			time.Sleep(500 * time.Millisecond)
+			ch.Send(42)
+		}()
+
+		got, ok, err := ch.RecvTimeout(time.Second)
+		if got != 42 || !ok || err != nil {
+			t.Errorf(
+				"Chan[int].RecvTimeout(1s) = %d, %v, %v, want 42, true, nil",
+				got, ok, err,
+			)
+		}
+
+		ch.Close()
+
+		if _, ok, err := ch.RecvTimeout(time.Second); ok || err != ErrClosed {
+			t.Errorf(
+				"Chan[int].RecvTimeout(1s) on closed channel = _, %v, %v, "+
+					"want false, %v",
+				ok, err, ErrClosed,
+			)
+		}
+	})
+}
+
+func TestChanRecvBatchWait(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var ch Chan[int]
+
+		go func() {
+			ch.Send(1)
+			ch.Send(2)
+			time.Sleep(2 * time.Second)
+			ch.Send(3)
+		}()
+
+		got := ch.RecvBatch(10, time.Second)
+		if want := []int{1, 2}; !slices.Equal(got, want) {
+			t.Errorf("Chan[int].RecvBatch(10, 1s) = %v, want %v", got, want)
+		}
+
+		got = ch.RecvBatch(10, time.Second)
+		if want := []int{3}; !slices.Equal(got, want) {
+			t.Errorf("Chan[int].RecvBatch(10, 1s) = %v, want %v", got, want)
+		}
+	})
+}
@@ -1,6 +1,12 @@
 package zeros
 
-import "testing"
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+)
 
 func TestChanClose(t *testing.T) {
 	var ch Chan[int]
@@ -24,3 +30,337 @@ func TestChanTryRecvClosed(t *testing.T) {
 		t.Error("Chan[int].TryRecv() on closed channel = true, want false")
 	}
 }
+
+func TestChanRecvCtxClosed(t *testing.T) {
+	var ch Chan[int]
+
+	ch.Close()
+
+	if _, err := ch.RecvCtx(context.Background()); err != ErrClosed {
+		t.Errorf(
+			"Chan[int].RecvCtx() on closed channel = %v, want %v",
+			err, ErrClosed,
+		)
+	}
+}
+
+func TestChanSendCtxCanceled(t *testing.T) {
+	var ch Chan[int]
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := ch.SendCtx(ctx, 42); err != context.Canceled {
+		t.Errorf(
+			"Chan[int].SendCtx() with canceled context = %v, want %v",
+			err, context.Canceled,
+		)
+	}
+}
+
+func TestChanRecvCtxCanceled(t *testing.T) {
+	var ch Chan[int]
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := ch.RecvCtx(ctx); err != context.Canceled {
+		t.Errorf(
+			"Chan[int].RecvCtx() with canceled context = %v, want %v",
+			err, context.Canceled,
+		)
+	}
+}
+
+func TestChanSetCap(t *testing.T) {
+	var ch Chan[int]
+
+	ch.SetCap(2)
+
+	if got, want := ch.Cap(), 2; got != want {
+		t.Errorf("Chan[int].Cap() = %d, want %d", got, want)
+	}
+	if !ch.TrySend(1) || !ch.TrySend(2) {
+		t.Fatal("Chan[int].TrySend() on buffered channel = false, want true")
+	}
+	if ch.TrySend(3) {
+		t.Error("Chan[int].TrySend() on full channel = true, want false")
+	}
+	if got, want := ch.Len(), 2; got != want {
+		t.Errorf("Chan[int].Len() = %d, want %d", got, want)
+	}
+}
+
+func TestChanZeroCap(t *testing.T) {
+	var ch Chan[int]
+
+	if got, want := ch.Cap(), 0; got != want {
+		t.Errorf("Chan[int].Cap() = %d, want %d", got, want)
+	}
+	if got, want := ch.Len(), 0; got != want {
+		t.Errorf("Chan[int].Len() = %d, want %d", got, want)
+	}
+}
+
+func TestChanSetCapAfterInit(t *testing.T) {
+	var ch Chan[int]
+
+	ch.Chan()
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Chan[int].SetCap() after init did not panic")
+		}
+	}()
+	ch.SetCap(1)
+}
+
+func TestChanSetCapNegative(t *testing.T) {
+	var ch Chan[int]
+
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("Chan[int].SetCap(-1) did not panic")
+			}
+		}()
+		ch.SetCap(-1)
+	}()
+
+	// The failed SetCap must not poison the channel.
+	ch.SetCap(1)
+	if got := ch.Cap(); got != 1 {
+		t.Errorf("Chan[int].Cap() after SetCap(1) = %d, want 1", got)
+	}
+}
+
+func TestChanAll(t *testing.T) {
+	var ch Chan[int]
+	ch.SetCap(3)
+
+	ch.SendAll(slices.Values([]int{1, 2, 3}))
+	ch.Close()
+
+	got := slices.Collect(ch.All())
+	if want := []int{1, 2, 3}; !slices.Equal(got, want) {
+		t.Errorf("slices.Collect(ch.All()) = %v, want %v", got, want)
+	}
+}
+
+func TestChanAllStop(t *testing.T) {
+	var ch Chan[int]
+	ch.SetCap(3)
+
+	ch.SendAll(slices.Values([]int{1, 2, 3}))
+
+	var n int
+	for range ch.All() {
+		n++
+		if n >= 2 {
+			break
+		}
+	}
+
+	if n != 2 {
+		t.Errorf("range ch.All() with break iterated %d times, want 2", n)
+	}
+	if got, want := ch.Len(), 1; got != want {
+		t.Errorf("Chan[int].Len() after break = %d, want %d", got, want)
+	}
+}
+
+func TestChanCloseTwice(t *testing.T) {
+	var ch Chan[int]
+
+	ch.Close()
+	ch.Close()
+
+	if !ch.Closed() {
+		t.Error("Chan[int].Closed() after Close() = false, want true")
+	}
+}
+
+func TestChanClosed(t *testing.T) {
+	var ch Chan[int]
+
+	if ch.Closed() {
+		t.Error("Chan[int].Closed() before Close() = true, want false")
+	}
+}
+
+func TestChanCheckSendClosed(t *testing.T) {
+	var ch Chan[int]
+
+	ch.Close()
+
+	if err := ch.CheckSend(42); err != ErrClosed {
+		t.Errorf(
+			"Chan[int].CheckSend(42) on closed channel = %v, want %v",
+			err, ErrClosed,
+		)
+	}
+	if err := ch.SendCtx(context.Background(), 42); err != ErrClosed {
+		t.Errorf(
+			"Chan[int].SendCtx(42) on closed channel = %v, want %v",
+			err, ErrClosed,
+		)
+	}
+	if _, err := ch.SendTimeout(42, time.Hour); err != ErrClosed {
+		t.Errorf(
+			"Chan[int].SendTimeout(42, 1h) on closed channel = %v, want %v",
+			err, ErrClosed,
+		)
+	}
+}
+
+func TestChanRecvTimeoutReuse(t *testing.T) {
+	var ch Chan[int]
+
+	ch.SetCap(1)
+
+	for range 3 {
+		if _, ok, _ := ch.RecvTimeout(time.Millisecond); ok {
+			t.Fatal("Chan[int].RecvTimeout(1ms) on empty channel = true")
+		}
+	}
+
+	ch.Send(42)
+
+	if got, ok, err := ch.RecvTimeout(time.Hour); !ok || got != 42 {
+		t.Errorf(
+			"Chan[int].RecvTimeout(1h) = %d, %v, %v, want 42, true, nil",
+			got, ok, err,
+		)
+	}
+}
+
+func TestChanTimeoutZero(t *testing.T) {
+	var ch Chan[int]
+	ch.SetCap(100)
+
+	for i := range 100 {
+		if ok, err := ch.SendTimeout(i, 0); !ok || err != nil {
+			t.Fatalf(
+				"Chan[int].SendTimeout(%d, 0) with buffer space = %t, %v, "+
+					"want true, nil",
+				i, ok, err,
+			)
+		}
+	}
+	if ok, err := ch.SendTimeout(100, 0); ok || err != nil {
+		t.Errorf(
+			"Chan[int].SendTimeout(100, 0) on full buffer = %t, %v, "+
+				"want false, nil",
+			ok, err,
+		)
+	}
+
+	for i := range 100 {
+		if got, ok, err := ch.RecvTimeout(0); !ok || got != i {
+			t.Fatalf(
+				"Chan[int].RecvTimeout(0) = %d, %t, %v, want %d, true, nil",
+				got, ok, err, i,
+			)
+		}
+	}
+	if _, ok, err := ch.RecvTimeout(0); ok || err != nil {
+		t.Errorf(
+			"Chan[int].RecvTimeout(0) on empty buffer = %t, %v, "+
+				"want false, nil",
+			ok, err,
+		)
+	}
+}
+
+func TestChanRecvBatchFull(t *testing.T) {
+	var ch Chan[int]
+	ch.SetCap(5)
+
+	ch.SendAll(slices.Values([]int{1, 2, 3, 4, 5}))
+
+	got := ch.RecvBatch(3, time.Hour)
+	if want := []int{1, 2, 3}; !slices.Equal(got, want) {
+		t.Errorf("Chan[int].RecvBatch(3, 1h) = %v, want %v", got, want)
+	}
+}
+
+func TestChanRecvBatchNoWait(t *testing.T) {
+	var ch Chan[int]
+	ch.SetCap(10)
+
+	for range 100 {
+		for i := range 10 {
+			ch.Send(i)
+		}
+		if got := ch.RecvBatch(10, 0); len(got) != 10 {
+			t.Fatalf(
+				"Chan[int].RecvBatch(10, 0) on full buffer = %v, "+
+					"want 10 values",
+				got,
+			)
+		}
+	}
+}
+
+func TestChanRecvBatchClosed(t *testing.T) {
+	var ch Chan[int]
+	ch.SetCap(2)
+
+	ch.Send(1)
+	ch.Close()
+
+	got := ch.RecvBatch(3, time.Hour)
+	if want := []int{1}; !slices.Equal(got, want) {
+		t.Errorf("Chan[int].RecvBatch(3, 1h) = %v, want %v", got, want)
+	}
+	if got := ch.RecvBatch(3, time.Hour); got != nil {
+		t.Errorf(
+			"Chan[int].RecvBatch(3, 1h) on drained channel = %v, want nil",
+			got,
+		)
+	}
+}
+
+func TestChanRecvBatchZero(t *testing.T) {
+	var ch Chan[int]
+	ch.SetCap(1)
+	ch.Send(1)
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Chan[int].RecvBatch(0, 1h) did not panic")
+		}
+		if got := ch.Len(); got != 1 {
+			t.Errorf("Chan[int].Len() after RecvBatch(0, 1h) = %d, want 1", got)
+		}
+	}()
+	ch.RecvBatch(0, time.Hour)
+}
+
+func TestChanCloseWithError(t *testing.T) {
+	var ch Chan[int]
+	errStop := errors.New("stop")
+
+	if err := ch.Err(); err != nil {
+		t.Errorf("Chan[int].Err() on open channel = %v, want nil", err)
+	}
+
+	ch.CloseWithError(errStop)
+	ch.CloseWithError(errors.New("ignored"))
+	ch.Close()
+
+	if _, ok := ch.CheckRecv(); ok {
+		t.Error("Chan[int].CheckRecv() after CloseWithError() = true")
+	}
+	if err := ch.Err(); err != errStop {
+		t.Errorf("Chan[int].Err() = %v, want %v", err, errStop)
+	}
+}
+
+func TestChanCloseErrNil(t *testing.T) {
+	var ch Chan[int]
+
+	ch.Close()
+
+	if err := ch.Err(); err != nil {
+		t.Errorf("Chan[int].Err() after Close() = %v, want nil", err)
+	}
+}
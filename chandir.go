@@ -0,0 +1,61 @@
+package zeros
+
+import "iter"
+
+// SendChan is a send-only view of a Chan, obtained from Chan.SendOnly.
+//
+// It does not expose the underlying channel, which could be closed
+// directly, bypassing Close.
+type SendChan[T any] struct{ c *Chan[T] }
+
+// SendOnly returns a send-only view of the channel.
+// The view shares the channel's lazily initialized state.
+func (c *Chan[T]) SendOnly() SendChan[T] { return SendChan[T]{c} }
+
+// Send sends a value on the channel, blocking until the value is sent.
+func (s SendChan[T]) Send(v T) { s.c.Send(v) }
+
+// TrySend attempts to send a value on the channel without blocking.
+// It reports whether the value was sent.
+func (s SendChan[T]) TrySend(v T) bool { return s.c.TrySend(v) }
+
+// Close closes the underlying channel.
+// Calls after the first have no effect.
+func (s SendChan[T]) Close() { s.c.Close() }
+
+// CloseWithError closes the underlying channel and records err, which
+// receivers can retrieve with Err once they observe the channel closed.
+// Calls after the first close have no effect.
+func (s SendChan[T]) CloseWithError(err error) { s.c.CloseWithError(err) }
+
+// RecvChan is a receive-only view of a Chan, obtained from Chan.RecvOnly.
+type RecvChan[T any] struct{ c *Chan[T] }
+
+// RecvOnly returns a receive-only view of the channel.
+// The view shares the channel's lazily initialized state.
+func (c *Chan[T]) RecvOnly() RecvChan[T] { return RecvChan[T]{c} }
+
+// Chan returns the underlying channel as a receive-only channel.
+func (r RecvChan[T]) Chan() <-chan T { return r.c.Chan() }
+
+// Recv receives a value from the channel, blocking until a value is available.
+// Returns the zero value if the channel is closed.
+func (r RecvChan[T]) Recv() T { return r.c.Recv() }
+
+// CheckRecv receives a value from the channel with a status indicator.
+// The boolean return value indicates whether the channel is open.
+func (r RecvChan[T]) CheckRecv() (T, bool) { return r.c.CheckRecv() }
+
+// TryRecv attempts to receive a value from the channel without blocking.
+// If a value is available, it returns the value and true.
+// If no value is available or the channel is closed, it returns the zero
+// value and false.
+func (r RecvChan[T]) TryRecv() (T, bool) { return r.c.TryRecv() }
+
+// All returns an iterator over values received from the channel.
+// Iteration stops when the channel is closed.
+func (r RecvChan[T]) All() iter.Seq[T] { return r.c.All() }
+
+// Err returns the error passed to CloseWithError.
+// It returns nil if the channel is open or was closed with Close.
+func (r RecvChan[T]) Err() error { return r.c.Err() }
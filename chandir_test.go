@@ -0,0 +1,64 @@
+package zeros
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestChanDirectional(t *testing.T) {
+	var ch Chan[int]
+	ch.SetCap(2)
+
+	s, r := ch.SendOnly(), ch.RecvOnly()
+
+	s.Send(1)
+	if !s.TrySend(2) {
+		t.Error("SendChan[int].TrySend(2) with buffer space = false")
+	}
+	s.Close()
+
+	if got, ok := r.TryRecv(); !ok || got != 1 {
+		t.Errorf("RecvChan[int].TryRecv() = %d, %v, want 1, true", got, ok)
+	}
+	if got := slices.Collect(r.All()); !slices.Equal(got, []int{2}) {
+		t.Errorf("slices.Collect(r.All()) = %v, want [2]", got)
+	}
+	if _, ok := r.CheckRecv(); ok {
+		t.Error("RecvChan[int].CheckRecv() after Close() = true, want false")
+	}
+}
+
+func TestChanDirectionalErr(t *testing.T) {
+	var ch Chan[int]
+
+	s, r := ch.SendOnly(), ch.RecvOnly()
+	errStop := errors.New("stop")
+
+	if err := r.Err(); err != nil {
+		t.Errorf("RecvChan[int].Err() while open = %v, want nil", err)
+	}
+
+	s.CloseWithError(errStop)
+
+	if _, ok := r.CheckRecv(); ok {
+		t.Error("RecvChan[int].CheckRecv() after CloseWithError() = true")
+	}
+	if err := r.Err(); err != errStop {
+		t.Errorf("RecvChan[int].Err() = %v, want %v", err, errStop)
+	}
+}
+
+func TestChanDirectionalShared(t *testing.T) {
+	var ch Chan[int]
+
+	s, r := ch.SendOnly(), ch.RecvOnly()
+	ch.SetCap(1)
+
+	if r.Chan() != ch.Chan() {
+		t.Error("RecvChan[int].Chan() is not the underlying channel")
+	}
+	if !s.TrySend(42) || ch.Len() != 1 {
+		t.Error("SendChan[int].TrySend(42) did not reach the shared channel")
+	}
+}
@@ -7,6 +7,28 @@
 // for concurrent access without external synchronization
 // (like Go's built-in map type).
 //
+// OrderedMap is a Map that iterates in insertion order.
+//
+// SyncMap has the same methods as Map but is safe for concurrent use.
+// ShardedMap does the same for high-contention workloads by spreading
+// keys across independently locked shards.
+//
+// UnboundedChan is a channel whose Send never blocks, backed by a
+// growable queue.
+//
+// Latest is a conflating channel whose Send overwrites any value not yet
+// received.
+//
+// PriorityChan delivers the highest-priority pending value first.
+//
+// ProducerChan is a Chan that closes itself once every registered
+// producer is done.
+//
+// Call is a request/reply channel for in-process servers.
+//
+// Broadcast delivers each published value to every subscriber, with a
+// configurable policy for subscribers that fall behind.
+//
 // Slice is a slice type whose Append method mutates in place and
 // returns the updated slice, letting package-level var initializers
 // across files append to a single value.
@@ -1,7 +1,15 @@
 package zeros_test
 
 import (
+	"cmp"
+	"context"
+	"errors"
 	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"sync"
+	"time"
 
 	"lesiw.io/zeros"
 )
@@ -56,6 +64,35 @@ func ExampleChan_CheckRecv() {
 	// channel closed
 }
 
+func ExampleChan_CheckSend() {
+	var ch zeros.Chan[int]
+
+	ch.Close()
+	ch.Close() // Close is idempotent
+
+	if err := ch.CheckSend(42); err != nil {
+		fmt.Println(err)
+	}
+	// Output: zeros: channel closed
+}
+
+func ExampleChan_CloseWithError() {
+	var ch zeros.Chan[int]
+
+	go func() {
+		ch.Send(1)
+		ch.CloseWithError(errors.New("upstream failed"))
+	}()
+
+	for v := range ch.All() {
+		fmt.Println(v)
+	}
+	fmt.Println(ch.Err())
+	// Output:
+	// 1
+	// upstream failed
+}
+
 func ExampleChan_TrySend() {
 	var ch zeros.Chan[int]
 
@@ -76,6 +113,84 @@ func ExampleChan_TryRecv() {
 	// Output: no value available
 }
 
+func ExampleChan_RecvCtx() {
+	var ch zeros.Chan[int]
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+
+	// RecvCtx returns ctx.Err() if no value arrives in time
+	if _, err := ch.RecvCtx(ctx); err != nil {
+		fmt.Println(err)
+	}
+	// Output: context deadline exceeded
+}
+
+func ExampleChan_SetCap() {
+	var ch zeros.Chan[string]
+	ch.SetCap(2)
+
+	// Sends do not block until the buffer is full
+	ch.Send("a")
+	ch.Send("b")
+	fmt.Println(ch.Len(), ch.Cap())
+	// Output: 2 2
+}
+
+func ExampleChan_All() {
+	var ch zeros.Chan[int]
+
+	go func() {
+		ch.SendAll(slices.Values([]int{1, 2, 3}))
+		ch.Close()
+	}()
+
+	for v := range ch.All() {
+		fmt.Println(v)
+	}
+	// Output:
+	// 1
+	// 2
+	// 3
+}
+
+func ExampleChan_RecvTimeout() {
+	var ch zeros.Chan[int]
+
+	if _, ok, err := ch.RecvTimeout(time.Millisecond); !ok && err == nil {
+		fmt.Println("timed out")
+	}
+
+	ch.Close()
+
+	if _, _, err := ch.RecvTimeout(time.Millisecond); err != nil {
+		fmt.Println(err)
+	}
+	// Output:
+	// timed out
+	// zeros: channel closed
+}
+
+func ExampleChan_SendOnly() {
+	type worker struct {
+		jobs zeros.Chan[string]
+	}
+	var w worker
+
+	// Hand out capability-restricted ends of the same channel
+	producer, consumer := w.jobs.SendOnly(), w.jobs.RecvOnly()
+
+	go func() {
+		producer.Send("job")
+		producer.Close()
+	}()
+
+	for job := range consumer.All() {
+		fmt.Println(job)
+	}
+	// Output: job
+}
+
 func ExampleMap() {
 	var m zeros.Map[string, int]
 
@@ -101,6 +216,22 @@ func ExampleMap_All() {
 	// foo bar
 }
 
+func ExampleSortedAll() {
+	var m zeros.Map[string, int]
+
+	m.Set("b", 2)
+	m.Set("c", 3)
+	m.Set("a", 1)
+
+	for k, v := range zeros.SortedAll(&m) {
+		fmt.Println(k, v)
+	}
+	// Output:
+	// a 1
+	// b 2
+	// c 3
+}
+
 func ExampleMap_Get() {
 	var m zeros.Map[string, int]
 
@@ -199,6 +330,34 @@ func ExampleMap_Clear() {
 	// 0
 }
 
+func ExampleMap_Update() {
+	var m zeros.Map[string, int]
+
+	for _, word := range []string{"a", "b", "a"} {
+		m.Update(word, func(n int, _ bool) (int, bool) {
+			return n + 1, true
+		})
+	}
+	fmt.Println(m.Get("a"), m.Get("b"))
+
+	// Returning false deletes the key.
+	m.Update("b", func(int, bool) (int, bool) { return 0, false })
+	fmt.Println(m.Len())
+	// Output:
+	// 2 1
+	// 1
+}
+
+func ExampleMap_GetOrSet() {
+	var m zeros.Map[string, int]
+
+	fmt.Println(m.GetOrSet("a", 1))
+	fmt.Println(m.GetOrSet("a", 2))
+	// Output:
+	// 1 false
+	// 1 true
+}
+
 func ExampleSlice() {
 	var s zeros.Slice[int]
 
@@ -237,3 +396,225 @@ func ExampleOnceValue() {
 	// Hello, World!
 	// Hello, World!
 }
+
+func ExampleBroadcast() {
+	b := zeros.Broadcast[string]{Buffer: 1}
+
+	c1, unsubscribe1 := b.Subscribe()
+	defer unsubscribe1()
+	c2, unsubscribe2 := b.Subscribe()
+	defer unsubscribe2()
+
+	b.Publish("hello")
+
+	fmt.Println(<-c1)
+	fmt.Println(<-c2)
+	// Output:
+	// hello
+	// hello
+}
+
+func ExampleUnboundedChan() {
+	var ch zeros.UnboundedChan[int]
+
+	// Send never blocks, even without a receiver
+	for i := range 3 {
+		ch.Send(i)
+	}
+	ch.Close()
+
+	for {
+		v, ok := ch.CheckRecv()
+		if !ok {
+			break
+		}
+		fmt.Println(v)
+	}
+	// Output:
+	// 0
+	// 1
+	// 2
+}
+
+func ExampleMerge() {
+	var a, b zeros.Chan[int]
+
+	go func() {
+		a.Send(1)
+		a.Close()
+	}()
+	go func() {
+		b.Send(2)
+		b.Close()
+	}()
+
+	for v := range zeros.Merge(context.Background(), &a, &b).All() {
+		fmt.Println(v)
+	}
+	// Unordered output:
+	// 1
+	// 2
+}
+
+func ExampleMapChanOrdered() {
+	var in zeros.Chan[int]
+
+	go func() {
+		in.SendAll(slices.Values([]int{1, 2, 3}))
+		in.Close()
+	}()
+
+	square := func(v int) int { return v * v }
+	out := zeros.MapChanOrdered(context.Background(), &in, 3, square)
+	for v := range out.All() {
+		fmt.Println(v)
+	}
+	// Output:
+	// 1
+	// 4
+	// 9
+}
+
+func ExampleLatest() {
+	var status zeros.Latest[string]
+
+	// Send never blocks; undelivered values are replaced
+	status.Send("starting")
+	status.Send("ready")
+
+	fmt.Println(status.Recv())
+	// Output: ready
+}
+
+func ExamplePriorityChan() {
+	jobs := zeros.PriorityChan[int]{Cmp: cmp.Compare[int]}
+
+	jobs.Send(1)
+	jobs.Send(9)
+	jobs.Send(5)
+	jobs.Close()
+
+	for {
+		v, ok := jobs.CheckRecv()
+		if !ok {
+			break
+		}
+		fmt.Println(v)
+	}
+	// Output:
+	// 9
+	// 5
+	// 1
+}
+
+func ExampleChanReader() {
+	var c zeros.Chan[[]byte]
+
+	go func() {
+		w := zeros.ChanWriter(&c)
+		fmt.Fprint(w, "hello, ")
+		fmt.Fprint(w, "world")
+		w.Close()
+	}()
+
+	data, err := io.ReadAll(zeros.ChanReader(&c))
+	if err != nil {
+		fmt.Printf("ReadAll failed: %v\n", err)
+		return
+	}
+	fmt.Println(string(data))
+	// Output: hello, world
+}
+
+func ExampleSelect() {
+	var ints zeros.Chan[int]
+	var strs zeros.Chan[string]
+
+	go func() {
+		ints.Send(42)
+		ints.Close()
+	}()
+	go func() {
+		strs.Send("hello")
+		strs.Close()
+	}()
+
+	err := zeros.Select(
+		zeros.OnRecv(&ints, func(v int) { fmt.Println("int:", v) }),
+		zeros.OnRecv(&strs, func(v string) { fmt.Println("string:", v) }),
+	).Loop()
+	fmt.Println(err)
+	// Unordered output:
+	// int: 42
+	// string: hello
+	// <nil>
+}
+
+func ExampleProducerChan() {
+	var results zeros.ProducerChan[int]
+
+	for i := range 3 {
+		done := results.AddProducer()
+		go func() {
+			defer done()
+			results.Send(i * i)
+		}()
+	}
+
+	// The channel closes once every producer is done
+	for v := range results.All() {
+		fmt.Println(v)
+	}
+	// Unordered output:
+	// 0
+	// 1
+	// 4
+}
+
+func ExampleCall() {
+	var upper zeros.Call[string, string]
+	defer upper.Close()
+
+	ctx := context.Background()
+	go upper.Serve(ctx, func(s string) (string, error) {
+		return strings.ToUpper(s), nil
+	})
+
+	resp, err := upper.Call(ctx, "hello")
+	fmt.Println(resp, err)
+	// Output: HELLO <nil>
+}
+
+func ExampleSyncMap() {
+	var m zeros.SyncMap[string, int]
+
+	var wg sync.WaitGroup
+	for _, k := range []string{"a", "b", "c"} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			m.Set(k, len(k))
+		}()
+	}
+	wg.Wait()
+
+	fmt.Println(m.Len())
+	// Output: 3
+}
+
+func ExampleOrderedMap() {
+	var m zeros.OrderedMap[string, int]
+
+	m.Set("b", 2)
+	m.Set("a", 1)
+	m.Set("c", 3)
+	m.MoveToFront("c")
+
+	for k, v := range m.All() {
+		fmt.Println(k, v)
+	}
+	// Output:
+	// c 3
+	// b 2
+	// a 1
+}
@@ -1,3 +1,3 @@
 module lesiw.io/zeros
 
-go 1.23
+go 1.24
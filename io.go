@@ -0,0 +1,69 @@
+package zeros
+
+import (
+	"bytes"
+	"io"
+)
+
+// ChanReader returns a reader over the byte slices received from c.
+//
+// Read returns io.EOF once c is closed, or the error passed to
+// c.CloseWithError if there is one. Closing the reader closes c.
+func ChanReader(c *Chan[[]byte]) io.ReadCloser { return &chanReader{c: c} }
+
+type chanReader struct {
+	c   *Chan[[]byte]
+	buf []byte // unread remainder of the last received slice
+}
+
+func (r *chanReader) Read(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	for len(r.buf) == 0 {
+		b, ok := r.c.CheckRecv()
+		if !ok {
+			if err := r.c.Err(); err != nil {
+				return 0, err
+			}
+			return 0, io.EOF
+		}
+		r.buf = b
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+func (r *chanReader) Close() error {
+	r.buf = nil
+	r.c.Close()
+	return nil
+}
+
+// ChanWriter returns a writer that sends a copy of each written slice on c.
+//
+// Write blocks until the slice is received. Once c is closed, Write
+// returns the error passed to c.CloseWithError, or ErrClosed if there is
+// none. Closing the writer closes c.
+func ChanWriter(c *Chan[[]byte]) io.WriteCloser { return chanWriter{c} }
+
+type chanWriter struct{ c *Chan[[]byte] }
+
+func (w chanWriter) Write(p []byte) (int, error) {
+	if len(p) == 0 {
+		return 0, nil
+	}
+	if err := w.c.CheckSend(bytes.Clone(p)); err != nil {
+		if cerr := w.c.Err(); cerr != nil {
+			return 0, cerr
+		}
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (w chanWriter) Close() error {
+	w.c.Close()
+	return nil
+}
@@ -0,0 +1,36 @@
+//go:build go1.25
+
+package zeros
+
+import (
+	"io"
+	"strings"
+	"testing"
+	"testing/synctest"
+)
+
+func TestChanReaderWriterCopy(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var c Chan[[]byte]
+		want := strings.Repeat("zeros", 1000)
+
+		go func() {
+			w := ChanWriter(&c)
+			if _, err := io.Copy(w, strings.NewReader(want)); err != nil {
+				t.Errorf("io.Copy(ChanWriter(c), ...) = %v", err)
+			}
+			w.Close()
+		}()
+
+		got, err := io.ReadAll(ChanReader(&c))
+		if err != nil {
+			t.Fatalf("io.ReadAll(ChanReader(c)) = %v", err)
+		}
+		if string(got) != want {
+			t.Errorf(
+				"io.ReadAll(ChanReader(c)) read %d bytes, want %d",
+				len(got), len(want),
+			)
+		}
+	})
+}
@@ -0,0 +1,76 @@
+package zeros
+
+import (
+	"errors"
+	"io"
+	"testing"
+	"testing/iotest"
+)
+
+func TestChanReader(t *testing.T) {
+	var c Chan[[]byte]
+	c.SetCap(3)
+
+	c.Send([]byte("hello, "))
+	c.Send(nil)
+	c.Send([]byte("world"))
+	c.Close()
+
+	want := []byte("hello, world")
+	if err := iotest.TestReader(ChanReader(&c), want); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestChanReaderErr(t *testing.T) {
+	var c Chan[[]byte]
+	errStop := errors.New("stop")
+	c.SetCap(1)
+
+	c.Send([]byte("data"))
+	c.CloseWithError(errStop)
+
+	got, err := io.ReadAll(ChanReader(&c))
+	if string(got) != "data" || err != errStop {
+		t.Errorf(
+			"io.ReadAll(ChanReader(c)) = %q, %v, want %q, %v",
+			got, err, "data", errStop,
+		)
+	}
+}
+
+func TestChanWriterCopies(t *testing.T) {
+	var c Chan[[]byte]
+	c.SetCap(1)
+
+	buf := []byte("abc")
+	w := ChanWriter(&c)
+	if n, err := w.Write(buf); n != 3 || err != nil {
+		t.Fatalf("ChanWriter(c).Write(%q) = %d, %v, want 3, nil", buf, n, err)
+	}
+	buf[0] = 'x'
+
+	if got := string(c.Recv()); got != "abc" {
+		t.Errorf(
+			"received %q after caller modified buffer, want %q",
+			got, "abc",
+		)
+	}
+}
+
+func TestChanWriterClosed(t *testing.T) {
+	var c Chan[[]byte]
+	r, w := ChanReader(&c), ChanWriter(&c)
+
+	r.Close()
+
+	if _, err := w.Write([]byte("data")); err != ErrClosed {
+		t.Errorf(
+			"ChanWriter(c).Write() after reader Close() = %v, want %v",
+			err, ErrClosed,
+		)
+	}
+	if err := w.Close(); err != nil {
+		t.Errorf("ChanWriter(c).Close() = %v, want nil", err)
+	}
+}
@@ -0,0 +1,113 @@
+package zeros
+
+import "sync"
+
+// Latest is a zero-valueable conflating channel that holds at most one
+// value. Send never blocks and replaces any value not yet received, so
+// receivers always get the most recent value.
+//
+// Latest is safe for concurrent use by multiple senders and receivers.
+type Latest[T any] struct {
+	once    OnceValue[latestState]
+	mu      sync.Mutex
+	v       T
+	pending bool
+	closed  bool
+}
+
+type latestState struct {
+	ready chan struct{} // signaled when a value is sent
+	done  chan struct{} // closed by Close
+}
+
+func (l *Latest[T]) state() latestState {
+	return l.once.Do(func() latestState {
+		return latestState{make(chan struct{}, 1), make(chan struct{})}
+	})
+}
+
+// Send stores a value, replacing any value not yet received.
+// It panics if the channel is closed.
+func (l *Latest[T]) Send(v T) {
+	s := l.state()
+	l.mu.Lock()
+	if l.closed {
+		l.mu.Unlock()
+		panic("zeros: send on closed Latest")
+	}
+	l.v, l.pending = v, true
+	l.mu.Unlock()
+	select {
+	case s.ready <- struct{}{}:
+	default:
+	}
+}
+
+// Recv receives the most recent value, blocking until a value is available.
+// Returns the zero value if the channel is closed.
+func (l *Latest[T]) Recv() T {
+	v, _ := l.CheckRecv()
+	return v
+}
+
+// CheckRecv receives the most recent value with a status indicator.
+// The boolean return value is false only once the channel is closed and
+// no value is pending.
+func (l *Latest[T]) CheckRecv() (T, bool) {
+	s := l.state()
+	for {
+		if v, ok, closed := l.take(); ok || closed {
+			return v, ok
+		}
+		select {
+		case <-s.ready:
+		case <-s.done:
+		}
+	}
+}
+
+// TryRecv attempts to receive the most recent value without blocking.
+// If a value is pending, it returns the value and true.
+// Otherwise, it returns the zero value and false.
+func (l *Latest[T]) TryRecv() (T, bool) {
+	v, ok, _ := l.take()
+	return v, ok
+}
+
+// Peek returns the pending value without receiving it.
+// If no value is pending, it returns the zero value and false.
+func (l *Latest[T]) Peek() (T, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if !l.pending {
+		var zero T
+		return zero, false
+	}
+	return l.v, true
+}
+
+// take removes and returns the pending value, if any, and reports
+// whether the channel is closed.
+func (l *Latest[T]) take() (v T, ok, closed bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.pending {
+		var zero T
+		v, l.v, l.pending = l.v, zero, false
+		return v, true, l.closed
+	}
+	return v, false, l.closed
+}
+
+// Close closes the channel. A pending value remains available to
+// receivers. Calls after the first have no effect.
+func (l *Latest[T]) Close() {
+	s := l.state()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.closed {
+		return
+	}
+	l.closed = true
+	close(s.done)
+}
@@ -0,0 +1,62 @@
+//go:build go1.25
+
+package zeros
+
+import (
+	"sync"
+	"testing"
+	"testing/synctest"
+)
+
+func TestLatestRecvBlocks(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var l Latest[int]
+		var got int
+
+		go func() { got = l.Recv() }()
+		synctest.Wait()
+
+		l.Send(42)
+		synctest.Wait()
+
+		if got != 42 {
+			t.Errorf("Latest[int].Recv() = %d, want 42", got)
+		}
+	})
+}
+
+func TestLatestCloseWakesRecv(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var l Latest[int]
+		ok := true
+
+		go func() { _, ok = l.CheckRecv() }()
+		synctest.Wait()
+
+		l.Close()
+		synctest.Wait()
+
+		if ok {
+			t.Error("Latest[int].CheckRecv() across Close() = true")
+		}
+	})
+}
+
+func TestLatestConcurrentSenders(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var l Latest[int]
+		var wg sync.WaitGroup
+
+		for i := range 10 {
+			wg.Go(func() { l.Send(i) })
+		}
+		wg.Wait()
+
+		if _, ok := l.TryRecv(); !ok {
+			t.Error("Latest[int].TryRecv() after sends = false, want true")
+		}
+		if _, ok := l.TryRecv(); ok {
+			t.Error("Latest[int].TryRecv() second call = true, want false")
+		}
+	})
+}
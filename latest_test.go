@@ -0,0 +1,56 @@
+package zeros
+
+import "testing"
+
+func TestLatestOverwrite(t *testing.T) {
+	var l Latest[int]
+
+	l.Send(1)
+	l.Send(2)
+	l.Send(3)
+
+	if got, ok := l.Peek(); !ok || got != 3 {
+		t.Errorf("Latest[int].Peek() = %d, %v, want 3, true", got, ok)
+	}
+	if got := l.Recv(); got != 3 {
+		t.Errorf("Latest[int].Recv() = %d, want 3", got)
+	}
+	if _, ok := l.TryRecv(); ok {
+		t.Error("Latest[int].TryRecv() after Recv() = true, want false")
+	}
+	if _, ok := l.Peek(); ok {
+		t.Error("Latest[int].Peek() after Recv() = true, want false")
+	}
+}
+
+func TestLatestClose(t *testing.T) {
+	var l Latest[int]
+
+	l.Send(42)
+	l.Close()
+	l.Close()
+
+	if got, ok := l.CheckRecv(); !ok || got != 42 {
+		t.Errorf(
+			"Latest[int].CheckRecv() with pending value after Close() "+
+				"= %d, %v, want 42, true",
+			got, ok,
+		)
+	}
+	if _, ok := l.CheckRecv(); ok {
+		t.Error("Latest[int].CheckRecv() after Close() = true, want false")
+	}
+}
+
+func TestLatestSendClosed(t *testing.T) {
+	var l Latest[int]
+
+	l.Close()
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Latest[int].Send() after Close() did not panic")
+		}
+	}()
+	l.Send(42)
+}
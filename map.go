@@ -1,6 +1,10 @@
 package zeros
 
-import "iter"
+import (
+	"cmp"
+	"iter"
+	"slices"
+)
 
 // Map is a zero-valueable map wrapper that auto-initializes on first use.
 type Map[K comparable, V any] struct{ once OnceValue[map[K]V] }
@@ -63,3 +67,110 @@ func (m *Map[K, V]) All() iter.Seq2[K, V] {
 
 // Clear removes all elements from the map.
 func (m *Map[K, V]) Clear() { clear(m.Map()) }
+
+// GetOrSet returns the existing value for key if present.
+// Otherwise, it sets key to value and returns value.
+// The boolean result reports whether the value was already present.
+func (m *Map[K, V]) GetOrSet(key K, value V) (V, bool) {
+	mm := m.Map()
+	if v, ok := mm[key]; ok {
+		return v, true
+	}
+	mm[key] = value
+	return value, false
+}
+
+// GetOrCompute returns the existing value for key if present.
+// Otherwise, it sets key to the result of f and returns it.
+// The boolean result reports whether the value was already present.
+func (m *Map[K, V]) GetOrCompute(key K, f func() V) (V, bool) {
+	mm := m.Map()
+	if v, ok := mm[key]; ok {
+		return v, true
+	}
+	v := f()
+	mm[key] = v
+	return v, false
+}
+
+// Update calls f with the current value for key and whether it is
+// present. If f returns true, key is set to the returned value;
+// otherwise, key is deleted. Update returns the results of f.
+func (m *Map[K, V]) Update(key K, f func(old V, ok bool) (V, bool)) (V, bool) {
+	mm := m.Map()
+	old, ok := mm[key]
+	v, keep := f(old, ok)
+	if keep {
+		mm[key] = v
+	} else {
+		delete(mm, key)
+	}
+	return v, keep
+}
+
+// Swap sets key to value and returns the previous value, if any.
+// The boolean result reports whether key was present.
+func (m *Map[K, V]) Swap(key K, value V) (V, bool) {
+	mm := m.Map()
+	prev, ok := mm[key]
+	mm[key] = value
+	return prev, ok
+}
+
+// CompareAndSwap sets key to new if key is present and its value is equal
+// to old. It reports whether the value was swapped.
+// Like sync.Map.CompareAndSwap, it panics if V is not comparable.
+func (m *Map[K, V]) CompareAndSwap(key K, old, new V) bool {
+	mm := m.Map()
+	if cur, ok := mm[key]; !ok || any(cur) != any(old) {
+		return false
+	}
+	mm[key] = new
+	return true
+}
+
+// SortedKeys returns an iterator over the keys of m in ascending order.
+func SortedKeys[K cmp.Ordered, V any](m *Map[K, V]) iter.Seq[K] {
+	return SortedKeysFunc(m, cmp.Compare[K])
+}
+
+// SortedKeysFunc returns an iterator over the keys of m in the order
+// defined by compare, which is as for slices.SortFunc.
+func SortedKeysFunc[K comparable, V any](
+	m *Map[K, V], compare func(a, b K) int,
+) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for _, k := range slices.SortedFunc(m.Keys(), compare) {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// SortedAll returns an iterator over the key-value pairs of m in
+// ascending key order.
+func SortedAll[K cmp.Ordered, V any](m *Map[K, V]) iter.Seq2[K, V] {
+	return SortedAllFunc(m, cmp.Compare[K])
+}
+
+// SortedAllFunc returns an iterator over the key-value pairs of m in the
+// key order defined by compare, which is as for slices.SortFunc.
+//
+// Keys are sorted when iteration begins; keys deleted during iteration
+// are skipped, and keys added are not produced.
+func SortedAllFunc[K comparable, V any](
+	m *Map[K, V], compare func(a, b K) int,
+) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, k := range slices.SortedFunc(m.Keys(), compare) {
+			v, ok := m.CheckGet(k)
+			if !ok {
+				continue
+			}
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
@@ -1,7 +1,9 @@
 package zeros
 
 import (
+	"cmp"
 	"maps"
+	"slices"
 	"testing"
 )
 
@@ -251,3 +253,204 @@ func TestMapMap(t *testing.T) {
 		)
 	}
 }
+
+func TestSortedKeys(t *testing.T) {
+	var m Map[string, int]
+
+	m.Set("c", 3)
+	m.Set("a", 1)
+	m.Set("b", 2)
+
+	got := slices.Collect(SortedKeys(&m))
+	if want := []string{"a", "b", "c"}; !slices.Equal(got, want) {
+		t.Errorf("slices.Collect(SortedKeys(&m)) = %v, want %v", got, want)
+	}
+}
+
+func TestSortedAll(t *testing.T) {
+	var m Map[int, string]
+
+	m.Set(3, "c")
+	m.Set(1, "a")
+	m.Set(2, "b")
+
+	var keys []int
+	var values []string
+	for k, v := range SortedAll(&m) {
+		keys = append(keys, k)
+		values = append(values, v)
+	}
+
+	if want := []int{1, 2, 3}; !slices.Equal(keys, want) {
+		t.Errorf("range SortedAll(&m) keys = %v, want %v", keys, want)
+	}
+	if want := []string{"a", "b", "c"}; !slices.Equal(values, want) {
+		t.Errorf("range SortedAll(&m) values = %v, want %v", values, want)
+	}
+}
+
+func TestSortedKeysFunc(t *testing.T) {
+	var m Map[int, bool]
+
+	for i := range 5 {
+		m.Set(i, true)
+	}
+
+	desc := func(a, b int) int { return b - a }
+	got := slices.Collect(SortedKeysFunc(&m, desc))
+	if want := []int{4, 3, 2, 1, 0}; !slices.Equal(got, want) {
+		t.Errorf(
+			"slices.Collect(SortedKeysFunc(&m, desc)) = %v, want %v",
+			got, want,
+		)
+	}
+}
+
+func TestSortedAllFuncDelete(t *testing.T) {
+	var m Map[int, int]
+
+	for i := range 4 {
+		m.Set(i, i)
+	}
+
+	var got []int
+	for k := range SortedAllFunc(&m, cmp.Compare[int]) {
+		got = append(got, k)
+		m.Delete(k + 1)
+	}
+
+	if want := []int{0, 2}; !slices.Equal(got, want) {
+		t.Errorf(
+			"range SortedAllFunc(&m) while deleting = %v, want %v",
+			got, want,
+		)
+	}
+}
+
+func TestSortedAllStop(t *testing.T) {
+	var m Map[int, int]
+
+	for i := range 3 {
+		m.Set(i, i)
+	}
+
+	var n int
+	for range SortedAll(&m) {
+		n++
+		if n >= 2 {
+			break
+		}
+	}
+
+	if n != 2 {
+		t.Errorf("range SortedAll(&m) with break iterated %d times, want 2", n)
+	}
+}
+
+func TestMapGetOrSet(t *testing.T) {
+	var m Map[string, int]
+
+	if v, loaded := m.GetOrSet("a", 1); v != 1 || loaded {
+		t.Errorf(
+			"Map[string, int].GetOrSet(\"a\", 1) = %d, %t, want 1, false",
+			v, loaded,
+		)
+	}
+	if v, loaded := m.GetOrSet("a", 2); v != 1 || !loaded {
+		t.Errorf(
+			"Map[string, int].GetOrSet(\"a\", 2) = %d, %t, want 1, true",
+			v, loaded,
+		)
+	}
+}
+
+func TestMapGetOrCompute(t *testing.T) {
+	var m Map[string, int]
+
+	var calls int
+	f := func() int {
+		calls++
+		return 1
+	}
+
+	m.GetOrCompute("a", f)
+	if v, loaded := m.GetOrCompute("a", f); v != 1 || !loaded {
+		t.Errorf(
+			"Map[string, int].GetOrCompute(\"a\", f) = %d, %t, want 1, true",
+			v, loaded,
+		)
+	}
+	if calls != 1 {
+		t.Errorf("GetOrCompute called f %d times, want 1", calls)
+	}
+}
+
+func TestMapUpdate(t *testing.T) {
+	var m Map[string, int]
+
+	incr := func(old int, ok bool) (int, bool) { return old + 1, true }
+	m.Update("a", incr)
+	if v, ok := m.Update("a", incr); v != 2 || !ok {
+		t.Errorf(
+			"Map[string, int].Update(\"a\", incr) = %d, %t, want 2, true",
+			v, ok,
+		)
+	}
+
+	m.Update("a", func(int, bool) (int, bool) { return 0, false })
+	if _, ok := m.CheckGet("a"); ok {
+		t.Error("Map[string, int].CheckGet(\"a\") after delete = true")
+	}
+}
+
+func TestMapSwap(t *testing.T) {
+	var m Map[string, int]
+
+	if prev, loaded := m.Swap("a", 1); prev != 0 || loaded {
+		t.Errorf(
+			"Map[string, int].Swap(\"a\", 1) = %d, %t, want 0, false",
+			prev, loaded,
+		)
+	}
+	if prev, loaded := m.Swap("a", 2); prev != 1 || !loaded {
+		t.Errorf(
+			"Map[string, int].Swap(\"a\", 2) = %d, %t, want 1, true",
+			prev, loaded,
+		)
+	}
+	if got := m.Get("a"); got != 2 {
+		t.Errorf("Map[string, int].Get(\"a\") = %d, want 2", got)
+	}
+}
+
+func TestMapCompareAndSwap(t *testing.T) {
+	var m Map[string, int]
+
+	if m.CompareAndSwap("a", 0, 1) {
+		t.Error("Map[string, int].CompareAndSwap on missing key = true")
+	}
+
+	m.Set("a", 1)
+
+	if m.CompareAndSwap("a", 2, 3) {
+		t.Error("Map[string, int].CompareAndSwap(\"a\", 2, 3) = true")
+	}
+	if !m.CompareAndSwap("a", 1, 3) {
+		t.Error("Map[string, int].CompareAndSwap(\"a\", 1, 3) = false")
+	}
+	if got := m.Get("a"); got != 3 {
+		t.Errorf("Map[string, int].Get(\"a\") = %d, want 3", got)
+	}
+}
+
+func TestMapCompareAndSwapPanics(t *testing.T) {
+	var m Map[string, []int]
+	m.Set("a", nil)
+
+	defer func() {
+		if recover() == nil {
+			t.Error("CompareAndSwap with uncomparable values did not panic")
+		}
+	}()
+	m.CompareAndSwap("a", nil, []int{1})
+}
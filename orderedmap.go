@@ -0,0 +1,193 @@
+package zeros
+
+import "iter"
+
+// OrderedMap is a zero-valueable map that remembers the order in which
+// keys were inserted. Keys, Values, and All iterate in that order, and
+// Backward iterates in reverse.
+//
+// Setting an existing key updates its value without changing its
+// position. Entries may be added, deleted, or moved during iteration.
+// Moving an entry is treated as deleting it and adding it at its new
+// position: deleted entries not yet reached are skipped, and entries added
+// ahead of the iterator may be produced. No entry is produced twice unless
+// it is moved ahead of the iterator.
+type OrderedMap[K comparable, V any] struct {
+	m           Map[K, *orderedEntry[K, V]]
+	front, back *orderedEntry[K, V]
+}
+
+type orderedEntry[K comparable, V any] struct {
+	key        K
+	value      V
+	prev, next *orderedEntry[K, V]
+	removed    bool
+}
+
+// Set sets a key-value pair. New keys are added at the back.
+func (m *OrderedMap[K, V]) Set(key K, value V) {
+	if e, ok := m.m.CheckGet(key); ok {
+		e.value = value
+		return
+	}
+	e := &orderedEntry[K, V]{key: key, value: value}
+	m.m.Set(key, e)
+	m.pushBack(e)
+}
+
+// Get retrieves a value by key, returning the zero value if not found.
+func (m *OrderedMap[K, V]) Get(key K) V {
+	v, _ := m.CheckGet(key)
+	return v
+}
+
+// CheckGet retrieves a value by key with a presence indicator.
+func (m *OrderedMap[K, V]) CheckGet(key K) (V, bool) {
+	if e, ok := m.m.CheckGet(key); ok {
+		return e.value, true
+	}
+	var zero V
+	return zero, false
+}
+
+// Delete removes a key.
+func (m *OrderedMap[K, V]) Delete(key K) {
+	e, ok := m.m.CheckGet(key)
+	if !ok {
+		return
+	}
+	m.m.Delete(key)
+	m.unlink(e)
+	e.removed = true
+}
+
+// Len returns the number of elements.
+func (m *OrderedMap[K, V]) Len() int { return m.m.Len() }
+
+// MoveToFront moves key to the front of the iteration order.
+// It reports whether key is present.
+func (m *OrderedMap[K, V]) MoveToFront(key K) bool {
+	e, ok := m.m.CheckGet(key)
+	if !ok {
+		return false
+	}
+	if e != m.front {
+		m.pushFront(m.replace(e))
+	}
+	return true
+}
+
+// MoveToBack moves key to the back of the iteration order.
+// It reports whether key is present.
+func (m *OrderedMap[K, V]) MoveToBack(key K) bool {
+	e, ok := m.m.CheckGet(key)
+	if !ok {
+		return false
+	}
+	if e != m.back {
+		m.pushBack(m.replace(e))
+	}
+	return true
+}
+
+// Keys returns an iterator over keys in insertion order.
+func (m *OrderedMap[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range m.All() {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over values in insertion order.
+func (m *OrderedMap[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range m.All() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over key-value pairs in insertion order.
+func (m *OrderedMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		// Deleted entries keep their links, so an iterator holding one
+		// can walk past it. Live entries never change order.
+		for e := m.front; e != nil; e = e.next {
+			if !e.removed && !yield(e.key, e.value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward returns an iterator over key-value pairs in reverse insertion
+// order.
+func (m *OrderedMap[K, V]) Backward() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for e := m.back; e != nil; e = e.prev {
+			if !e.removed && !yield(e.key, e.value) {
+				return
+			}
+		}
+	}
+}
+
+// Clear removes all elements from the map.
+func (m *OrderedMap[K, V]) Clear() {
+	for e := range m.m.Values() {
+		e.removed = true
+	}
+	m.m.Clear()
+	m.front, m.back = nil, nil
+}
+
+func (m *OrderedMap[K, V]) pushBack(e *orderedEntry[K, V]) {
+	e.prev, e.next = m.back, nil
+	if m.back != nil {
+		m.back.next = e
+	} else {
+		m.front = e
+	}
+	m.back = e
+}
+
+func (m *OrderedMap[K, V]) pushFront(e *orderedEntry[K, V]) {
+	e.prev, e.next = nil, m.front
+	if m.front != nil {
+		m.front.prev = e
+	} else {
+		m.back = e
+	}
+	m.front = e
+}
+
+// replace removes e from the list and returns a new, unlinked entry with
+// the same key and value. Moving an entry this way rather than relinking
+// it keeps iterators that hold e on e's old path.
+func (m *OrderedMap[K, V]) replace(e *orderedEntry[K, V]) *orderedEntry[K, V] {
+	m.unlink(e)
+	e.removed = true
+	n := &orderedEntry[K, V]{key: e.key, value: e.value}
+	m.m.Set(e.key, n)
+	return n
+}
+
+// unlink removes e from the list, leaving e's own links intact so that
+// an iterator holding e can continue past it.
+func (m *OrderedMap[K, V]) unlink(e *orderedEntry[K, V]) {
+	if e.prev != nil {
+		e.prev.next = e.next
+	} else {
+		m.front = e.next
+	}
+	if e.next != nil {
+		e.next.prev = e.prev
+	} else {
+		m.back = e.prev
+	}
+}
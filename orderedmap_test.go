@@ -0,0 +1,233 @@
+package zeros
+
+import (
+	"iter"
+	"slices"
+	"testing"
+)
+
+func collectKeys[K, V any](seq iter.Seq2[K, V]) []K {
+	var keys []K
+	for k := range seq {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func TestOrderedMapOrder(t *testing.T) {
+	var m OrderedMap[string, int]
+
+	m.Set("c", 3)
+	m.Set("a", 1)
+	m.Set("b", 2)
+	m.Set("c", 30)
+
+	got := slices.Collect(m.Keys())
+	if want := []string{"c", "a", "b"}; !slices.Equal(got, want) {
+		t.Errorf("slices.Collect(m.Keys()) = %v, want %v", got, want)
+	}
+	values := slices.Collect(m.Values())
+	if want := []int{30, 1, 2}; !slices.Equal(values, want) {
+		t.Errorf("slices.Collect(m.Values()) = %v, want %v", values, want)
+	}
+	if got := m.Get("c"); got != 30 {
+		t.Errorf("OrderedMap[string, int].Get(%q) = %d, want 30", "c", got)
+	}
+}
+
+func TestOrderedMapBackward(t *testing.T) {
+	var m OrderedMap[string, int]
+
+	m.Set("a", 1)
+	m.Set("b", 2)
+	m.Set("c", 3)
+
+	got := collectKeys(m.Backward())
+	if want := []string{"c", "b", "a"}; !slices.Equal(got, want) {
+		t.Errorf("collectKeys(m.Backward()) = %v, want %v", got, want)
+	}
+}
+
+func TestOrderedMapDelete(t *testing.T) {
+	var m OrderedMap[string, int]
+
+	m.Set("a", 1)
+	m.Set("b", 2)
+	m.Set("c", 3)
+	m.Delete("b")
+	m.Delete("missing")
+
+	if _, ok := m.CheckGet("b"); ok {
+		t.Error("OrderedMap[string, int].CheckGet(\"b\") after Delete = true")
+	}
+	if got := m.Len(); got != 2 {
+		t.Errorf("OrderedMap[string, int].Len() = %d, want 2", got)
+	}
+	got := collectKeys(m.All())
+	if want := []string{"a", "c"}; !slices.Equal(got, want) {
+		t.Errorf("collectKeys(m.All()) = %v, want %v", got, want)
+	}
+
+	m.Set("b", 20)
+
+	got = collectKeys(m.All())
+	if want := []string{"a", "c", "b"}; !slices.Equal(got, want) {
+		t.Errorf("collectKeys(m.All()) after re-Set = %v, want %v", got, want)
+	}
+}
+
+func TestOrderedMapMove(t *testing.T) {
+	var m OrderedMap[string, int]
+
+	m.Set("a", 1)
+	m.Set("b", 2)
+	m.Set("c", 3)
+
+	if !m.MoveToFront("c") {
+		t.Error("OrderedMap[string, int].MoveToFront(\"c\") = false")
+	}
+	if !m.MoveToBack("a") {
+		t.Error("OrderedMap[string, int].MoveToBack(\"a\") = false")
+	}
+	if m.MoveToBack("missing") || m.MoveToFront("missing") {
+		t.Error("OrderedMap[string, int] moved missing key")
+	}
+
+	got := collectKeys(m.All())
+	if want := []string{"c", "b", "a"}; !slices.Equal(got, want) {
+		t.Errorf("collectKeys(m.All()) = %v, want %v", got, want)
+	}
+	got = collectKeys(m.Backward())
+	if want := []string{"a", "b", "c"}; !slices.Equal(got, want) {
+		t.Errorf("collectKeys(m.Backward()) = %v, want %v", got, want)
+	}
+}
+
+func TestOrderedMapDeleteDuringIteration(t *testing.T) {
+	var m OrderedMap[int, int]
+
+	for i := range 5 {
+		m.Set(i, i)
+	}
+
+	var got []int
+	for k := range m.All() {
+		got = append(got, k)
+		m.Delete(k)
+		m.Delete(k + 1)
+	}
+
+	if want := []int{0, 2, 4}; !slices.Equal(got, want) {
+		t.Errorf("range m.All() while deleting visited %v, want %v", got, want)
+	}
+	if got := m.Len(); got != 0 {
+		t.Errorf("OrderedMap[int, int].Len() = %d, want 0", got)
+	}
+}
+
+func TestOrderedMapMoveDuringIteration(t *testing.T) {
+	tests := []struct {
+		name string
+		move func(m *OrderedMap[string, int]) bool
+		want []string
+	}{{
+		name: "MoveToFront behind",
+		move: func(m *OrderedMap[string, int]) bool {
+			return m.MoveToFront("b")
+		},
+		want: []string{"a", "c", "d"},
+	}, {
+		name: "MoveToBack ahead",
+		move: func(m *OrderedMap[string, int]) bool {
+			return m.MoveToBack("b")
+		},
+		want: []string{"a", "c", "d", "b"},
+	}, {
+		name: "MoveToBack current",
+		move: func(m *OrderedMap[string, int]) bool {
+			return m.MoveToBack("a")
+		},
+		want: []string{"a", "b", "c", "d", "a"},
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var m OrderedMap[string, int]
+			for _, k := range []string{"a", "b", "c", "d"} {
+				m.Set(k, 0)
+			}
+
+			var got []string
+			moved := false
+			for k := range m.Keys() {
+				got = append(got, k)
+				if !moved {
+					moved = tt.move(&m)
+				}
+			}
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("range m.Keys() visited %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOrderedMapBackwardMoveDuringIteration(t *testing.T) {
+	var m OrderedMap[string, int]
+	for _, k := range []string{"a", "b", "c", "d"} {
+		m.Set(k, 0)
+	}
+
+	var got []string
+	for k := range m.Backward() {
+		got = append(got, k)
+		if k == "d" {
+			m.MoveToBack("c")
+		}
+	}
+
+	if want := []string{"d", "b", "a"}; !slices.Equal(got, want) {
+		t.Errorf("range m.Backward() visited %v, want %v", got, want)
+	}
+}
+
+func TestOrderedMapClear(t *testing.T) {
+	var m OrderedMap[int, int]
+
+	m.Set(1, 1)
+	m.Set(2, 2)
+	m.Clear()
+
+	if got := m.Len(); got != 0 {
+		t.Errorf("OrderedMap[int, int].Len() after Clear() = %d, want 0", got)
+	}
+	if got := collectKeys(m.All()); len(got) != 0 {
+		t.Errorf("collectKeys(m.All()) after Clear() = %v, want []", got)
+	}
+
+	m.Set(3, 3)
+
+	if got := collectKeys(m.All()); !slices.Equal(got, []int{3}) {
+		t.Errorf("collectKeys(m.All()) = %v, want [3]", got)
+	}
+}
+
+func TestOrderedMapAllStop(t *testing.T) {
+	var m OrderedMap[int, int]
+
+	for i := range 3 {
+		m.Set(i, i)
+	}
+
+	var n int
+	for range m.All() {
+		n++
+		if n >= 2 {
+			break
+		}
+	}
+
+	if n != 2 {
+		t.Errorf("range m.All() with break iterated %d times, want 2", n)
+	}
+}
@@ -0,0 +1,266 @@
+package zeros
+
+import (
+	"context"
+	"sync"
+)
+
+// Merge returns a channel that receives every value received from chans.
+// The returned channel is closed once every input is closed or ctx is done.
+func Merge[T any](ctx context.Context, chans ...*Chan[T]) *Chan[T] {
+	out := new(Chan[T])
+	var wg sync.WaitGroup
+	wg.Add(len(chans))
+	for _, c := range chans {
+		go func() {
+			defer wg.Done()
+			for {
+				v, err := c.RecvCtx(ctx)
+				if err != nil {
+					return
+				}
+				if err := out.SendCtx(ctx, v); err != nil {
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		out.Close()
+	}()
+	return out
+}
+
+// Tee returns n channels that each receive every value received from in.
+// Each value is delivered to every output before the next value is
+// received, so a slow consumer holds back the others.
+// The returned channels are closed once in is closed or ctx is done.
+//
+// Tee panics if n is negative. If n is zero, it returns nil and does not
+// receive from in.
+func Tee[T any](ctx context.Context, in *Chan[T], n int) []*Chan[T] {
+	if n < 0 {
+		panic("zeros: Tee called with negative n")
+	}
+	if n == 0 {
+		return nil
+	}
+	outs := make([]*Chan[T], n)
+	for i := range outs {
+		outs[i] = new(Chan[T])
+	}
+	go func() {
+		defer func() {
+			for _, out := range outs {
+				out.Close()
+			}
+		}()
+		for {
+			v, err := in.RecvCtx(ctx)
+			if err != nil {
+				return
+			}
+			for _, out := range outs {
+				if err := out.SendCtx(ctx, v); err != nil {
+					return
+				}
+			}
+		}
+	}()
+	return outs
+}
+
+// MapChan returns a channel that receives f(v) for every value v received
+// from in, computed by up to workers goroutines. Workers are started as
+// values arrive and exit once idle. Results are delivered as they complete; use
+// MapChanOrdered to preserve input order.
+//
+// The returned channel is closed once in is closed and every result has
+// been delivered, or once ctx is done.
+func MapChan[A, B any](
+	ctx context.Context, in *Chan[A], workers int, f func(A) B,
+) *Chan[B] {
+	return stage(ctx, in, workers, false, func(v A) (B, bool) {
+		return f(v), true
+	})
+}
+
+// MapChanOrdered is like MapChan but delivers results in input order.
+func MapChanOrdered[A, B any](
+	ctx context.Context, in *Chan[A], workers int, f func(A) B,
+) *Chan[B] {
+	return stage(ctx, in, workers, true, func(v A) (B, bool) {
+		return f(v), true
+	})
+}
+
+// FilterChan returns a channel that receives every value v received from
+// in for which keep(v) is true, evaluated by up to workers goroutines.
+// Workers are started as values arrive and exit once idle. Values are
+// delivered as they are
+// evaluated; use FilterChanOrdered to preserve input order.
+//
+// The returned channel is closed once in is closed and every value has
+// been delivered, or once ctx is done.
+func FilterChan[T any](
+	ctx context.Context, in *Chan[T], workers int, keep func(T) bool,
+) *Chan[T] {
+	return stage(ctx, in, workers, false, func(v T) (T, bool) {
+		return v, keep(v)
+	})
+}
+
+// FilterChanOrdered is like FilterChan but delivers values in input order.
+func FilterChanOrdered[T any](
+	ctx context.Context, in *Chan[T], workers int, keep func(T) bool,
+) *Chan[T] {
+	return stage(ctx, in, workers, true, func(v T) (T, bool) {
+		return v, keep(v)
+	})
+}
+
+type stageJob[A, B any] struct {
+	v   A
+	res chan stageResult[B] // nil unless order is preserved
+}
+
+type stageResult[B any] struct {
+	v  B
+	ok bool
+}
+
+// stage sends f(v) to the returned channel for every v received from in
+// for which f reports true, using up to workers goroutines.
+//
+// A dispatcher goroutine receives from in until it is closed and hands
+// each value to an idle worker, starting a new worker if none is idle and
+// fewer than workers are running. A worker exits when no value is waiting
+// for it after finishing one. When ordered is set, the dispatcher also
+// queues a result slot per value, and a collector goroutine forwards the
+// slots' results in queue order. An idle stage holds only the dispatcher
+// and collector goroutines.
+func stage[A, B any](
+	ctx context.Context, in *Chan[A], workers int, ordered bool,
+	f func(A) (B, bool),
+) *Chan[B] {
+	workers = max(workers, 1)
+	ctx, cancel := context.WithCancel(ctx)
+	out := new(Chan[B])
+	jobs := make(chan stageJob[A, B])
+	var slots chan chan stageResult[B]
+	if ordered {
+		slots = make(chan chan stageResult[B], workers)
+	}
+
+	// exited receives once from every worker that returns. It never fills,
+	// since no more than workers are running.
+	exited := make(chan struct{}, workers)
+	work := func(j stageJob[A, B]) {
+		defer func() { exited <- struct{}{} }()
+		for {
+			v, ok := f(j.v)
+			if j.res != nil {
+				j.res <- stageResult[B]{v, ok}
+			} else if ok {
+				if err := out.SendCtx(ctx, v); err != nil {
+					cancel()
+					return
+				}
+			}
+			select {
+			case next, more := <-jobs:
+				if !more {
+					return
+				}
+				j = next
+			default:
+				return
+			}
+		}
+	}
+
+	go func() {
+		var wg sync.WaitGroup
+		defer func() {
+			close(jobs)
+			wg.Wait()
+			if ordered {
+				close(slots)
+			} else {
+				out.Close()
+				cancel()
+			}
+		}()
+		var running int
+		dispatch := func(j stageJob[A, B]) bool {
+			for {
+				select {
+				case jobs <- j:
+					return true
+				case <-exited:
+					running--
+					continue
+				default:
+				}
+				if running < workers {
+					running++
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+						work(j)
+					}()
+					return true
+				}
+				select {
+				case jobs <- j:
+					return true
+				case <-exited:
+					running--
+				case <-ctx.Done():
+					return false
+				}
+			}
+		}
+		for {
+			v, err := in.RecvCtx(ctx)
+			if err != nil {
+				return
+			}
+			j := stageJob[A, B]{v: v}
+			if ordered {
+				j.res = make(chan stageResult[B], 1)
+				select {
+				case slots <- j.res:
+				case <-ctx.Done():
+					return
+				}
+			}
+			if !dispatch(j) {
+				return
+			}
+		}
+	}()
+
+	if ordered {
+		go func() {
+			defer cancel()
+			defer out.Close()
+			for res := range slots {
+				var r stageResult[B]
+				select {
+				case r = <-res:
+				case <-ctx.Done():
+					return
+				}
+				if !r.ok {
+					continue
+				}
+				if err := out.SendCtx(ctx, r.v); err != nil {
+					return
+				}
+			}
+		}()
+	}
+	return out
+}
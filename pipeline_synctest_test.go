@@ -0,0 +1,300 @@
+//go:build go1.25
+
+package zeros
+
+import (
+	"context"
+	"runtime"
+	"slices"
+	"sync"
+	"testing"
+	"testing/synctest"
+	"time"
+)
+
+func TestMerge(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var a, b Chan[int]
+
+		go func() {
+			a.SendAll(slices.Values([]int{1, 2}))
+			a.Close()
+		}()
+		go func() {
+			b.SendAll(slices.Values([]int{3, 4}))
+			b.Close()
+		}()
+
+		got := slices.Sorted(Merge(t.Context(), &a, &b).All())
+		if want := []int{1, 2, 3, 4}; !slices.Equal(got, want) {
+			t.Errorf("Merge(a, b) received %v, want %v", got, want)
+		}
+	})
+}
+
+func TestMergeEmpty(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		out := Merge[int](t.Context())
+
+		if _, ok := out.CheckRecv(); ok {
+			t.Error("Merge() with no inputs returned open channel")
+		}
+	})
+}
+
+func TestMergeCanceled(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var a Chan[int]
+		ctx, cancel := context.WithCancel(t.Context())
+
+		out := Merge(ctx, &a)
+		cancel()
+
+		if _, ok := out.CheckRecv(); ok {
+			t.Error("Merge() output open after cancel")
+		}
+	})
+}
+
+func TestTee(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var in Chan[int]
+
+		go func() {
+			in.SendAll(slices.Values([]int{1, 2, 3}))
+			in.Close()
+		}()
+
+		outs := Tee(t.Context(), &in, 2)
+		got := make([][]int, len(outs))
+		for i, out := range outs {
+			go func() { got[i] = slices.Collect(out.All()) }()
+		}
+		synctest.Wait()
+
+		want := []int{1, 2, 3}
+		for i := range outs {
+			if !slices.Equal(got[i], want) {
+				t.Errorf(
+					"Tee() output %d received %v, want %v",
+					i, got[i], want,
+				)
+			}
+		}
+	})
+}
+
+func TestTeeCanceled(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var in Chan[int]
+		ctx, cancel := context.WithCancel(t.Context())
+
+		outs := Tee(ctx, &in, 2)
+		cancel()
+
+		for i, out := range outs {
+			if _, ok := out.CheckRecv(); ok {
+				t.Errorf("Tee() output %d open after cancel", i)
+			}
+		}
+	})
+}
+
+func TestTeeZero(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var in Chan[int]
+
+		if outs := Tee(t.Context(), &in, 0); outs != nil {
+			t.Errorf("Tee(ctx, in, 0) = %v, want nil", outs)
+		}
+		synctest.Wait()
+
+		if in.TrySend(1) {
+			t.Error("Tee(ctx, in, 0) received from in")
+		}
+	})
+}
+
+func TestTeeNegative(t *testing.T) {
+	var in Chan[int]
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Tee(ctx, in, -1) did not panic")
+		}
+	}()
+	Tee(t.Context(), &in, -1)
+}
+
+func TestMapChan(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var in Chan[int]
+
+		go func() {
+			in.SendAll(slices.Values([]int{1, 2, 3, 4}))
+			in.Close()
+		}()
+
+		out := MapChan(t.Context(), &in, 2, func(v int) int { return v * 10 })
+
+		got := slices.Sorted(out.All())
+		if want := []int{10, 20, 30, 40}; !slices.Equal(got, want) {
+			t.Errorf("MapChan() received %v, want %v", got, want)
+		}
+	})
+}
+
+func TestMapChanIdleWorkersExit(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var in Chan[int]
+
+		out := MapChan(t.Context(), &in, 4, func(v int) int {
+			time.Sleep(time.Second)
+			return v
+		})
+		synctest.Wait()
+		idle := runtime.NumGoroutine()
+
+		go in.SendAll(slices.Values([]int{1, 2, 3, 4, 5, 6, 7, 8}))
+		for range 8 {
+			out.Recv()
+		}
+		synctest.Wait()
+
+		if got := runtime.NumGoroutine(); got != idle {
+			t.Errorf(
+				"runtime.NumGoroutine() after burst = %d, want %d",
+				got, idle,
+			)
+		}
+	})
+}
+
+func TestMapChanOrdered(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var in Chan[int]
+
+		go func() {
+			in.SendAll(slices.Values([]int{4, 3, 2, 1}))
+			in.Close()
+		}()
+
+		// Later inputs finish first.
+		out := MapChanOrdered(t.Context(), &in, 4, func(v int) int {
+			time.Sleep(time.Duration(v) * time.Second)
+			return v * 10
+		})
+
+		got := slices.Collect(out.All())
+		if want := []int{40, 30, 20, 10}; !slices.Equal(got, want) {
+			t.Errorf("MapChanOrdered() received %v, want %v", got, want)
+		}
+	})
+}
+
+func TestMapChanWorkers(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var in Chan[int]
+		var running, peak int
+		var mu sync.Mutex
+
+		go func() {
+			in.SendAll(slices.Values([]int{1, 2, 3, 4, 5, 6}))
+			in.Close()
+		}()
+
+		out := MapChan(t.Context(), &in, 3, func(v int) int {
+			mu.Lock()
+			running++
+			peak = max(peak, running)
+			mu.Unlock()
+			time.Sleep(time.Second)
+			mu.Lock()
+			running--
+			mu.Unlock()
+			return v
+		})
+
+		if got := len(slices.Collect(out.All())); got != 6 {
+			t.Errorf("MapChan() received %d values, want 6", got)
+		}
+		if peak > 3 {
+			t.Errorf("MapChan() ran %d workers at once, want at most 3", peak)
+		}
+	})
+}
+
+func TestMapChanCanceled(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var in Chan[int]
+		ctx, cancel := context.WithCancel(t.Context())
+
+		in.SetCap(3)
+		in.SendAll(slices.Values([]int{1, 2, 3}))
+
+		out := MapChan(ctx, &in, 2, func(v int) int { return v })
+		synctest.Wait()
+		cancel()
+
+		// Every stage goroutine must exit; synctest.Test reports any
+		// that remain blocked.
+		for range out.All() {
+		}
+		in.Close()
+	})
+}
+
+func TestFilterChan(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var in Chan[int]
+
+		go func() {
+			in.SendAll(slices.Values([]int{1, 2, 3, 4, 5, 6}))
+			in.Close()
+		}()
+
+		even := func(v int) bool { return v%2 == 0 }
+		out := FilterChan(t.Context(), &in, 2, even)
+
+		got := slices.Sorted(out.All())
+		if want := []int{2, 4, 6}; !slices.Equal(got, want) {
+			t.Errorf("FilterChan() received %v, want %v", got, want)
+		}
+	})
+}
+
+func TestFilterChanOrdered(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var in Chan[int]
+
+		go func() {
+			in.SendAll(slices.Values([]int{6, 5, 4, 3, 2, 1}))
+			in.Close()
+		}()
+
+		out := FilterChanOrdered(t.Context(), &in, 3, func(v int) bool {
+			time.Sleep(time.Duration(v) * time.Second)
+			return v%2 == 0
+		})
+
+		got := slices.Collect(out.All())
+		if want := []int{6, 4, 2}; !slices.Equal(got, want) {
+			t.Errorf("FilterChanOrdered() received %v, want %v", got, want)
+		}
+	})
+}
+
+func TestMapChanOutputClosed(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var in Chan[int]
+
+		in.SetCap(3)
+		in.SendAll(slices.Values([]int{1, 2, 3}))
+
+		out := MapChan(t.Context(), &in, 2, func(v int) int { return v })
+		out.Recv()
+		out.Close()
+		synctest.Wait()
+		in.Close()
+	})
+}
@@ -0,0 +1,183 @@
+package zeros
+
+import "sync"
+
+// PriorityChan is a zero-valueable channel that delivers the
+// highest-priority pending value first.
+//
+// Values are ordered by Cmp: Cmp(a, b) > 0 means a has higher priority
+// than b. Values of equal priority, and all values when Cmp is nil, are
+// delivered in the order they were sent. Cmp is typically cmp.Compare for
+// ordered element types.
+//
+// Cap bounds the number of pending values: Send blocks while Cap values
+// are pending. If Cap is zero, the channel is unbounded.
+//
+// Cmp and Cap must not be changed after first use.
+// PriorityChan is safe for concurrent use.
+type PriorityChan[T any] struct {
+	// Cmp compares the priorities of two values.
+	Cmp func(a, b T) int
+	// Cap is the maximum number of pending values, or zero for no limit.
+	Cap int
+
+	conds  OnceValues[*sync.Cond, *sync.Cond] // notEmpty, notFull
+	mu     sync.Mutex
+	heap   []prioItem[T]
+	seq    uint64
+	closed bool
+}
+
+type prioItem[T any] struct {
+	v   T
+	seq uint64
+}
+
+func (c *PriorityChan[T]) init() (notEmpty, notFull *sync.Cond) {
+	return c.conds.Do(func() (*sync.Cond, *sync.Cond) {
+		return sync.NewCond(&c.mu), sync.NewCond(&c.mu)
+	})
+}
+
+// Send sends a value on the channel, blocking while the channel is full.
+// It panics if the channel is closed.
+func (c *PriorityChan[T]) Send(v T) {
+	notEmpty, notFull := c.init()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for c.full() && !c.closed {
+		notFull.Wait()
+	}
+	if c.closed {
+		panic("zeros: send on closed PriorityChan")
+	}
+	c.push(v)
+	notEmpty.Signal()
+}
+
+// TrySend attempts to send a value on the channel without blocking.
+// It reports whether the value was sent.
+// It panics if the channel is closed.
+func (c *PriorityChan[T]) TrySend(v T) bool {
+	notEmpty, _ := c.init()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		panic("zeros: send on closed PriorityChan")
+	}
+	if c.full() {
+		return false
+	}
+	c.push(v)
+	notEmpty.Signal()
+	return true
+}
+
+// Recv receives the highest-priority value, blocking until a value is
+// available. Returns the zero value if the channel is closed and empty.
+func (c *PriorityChan[T]) Recv() T {
+	v, _ := c.CheckRecv()
+	return v
+}
+
+// CheckRecv receives the highest-priority value with a status indicator.
+// The boolean return value is false only once the channel is closed and
+// no values are pending.
+func (c *PriorityChan[T]) CheckRecv() (T, bool) {
+	notEmpty, notFull := c.init()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for len(c.heap) == 0 && !c.closed {
+		notEmpty.Wait()
+	}
+	if len(c.heap) == 0 {
+		var zero T
+		return zero, false
+	}
+	notFull.Signal()
+	return c.pop(), true
+}
+
+// TryRecv attempts to receive the highest-priority value without blocking.
+// If a value is pending, it returns the value and true.
+// Otherwise, it returns the zero value and false.
+func (c *PriorityChan[T]) TryRecv() (T, bool) {
+	_, notFull := c.init()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.heap) == 0 {
+		var zero T
+		return zero, false
+	}
+	notFull.Signal()
+	return c.pop(), true
+}
+
+// Len returns the number of pending values.
+func (c *PriorityChan[T]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.heap)
+}
+
+// Close closes the channel. Pending values remain available to receivers.
+// Calls after the first have no effect.
+func (c *PriorityChan[T]) Close() {
+	notEmpty, notFull := c.init()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.closed = true
+	notEmpty.Broadcast()
+	notFull.Broadcast()
+}
+
+func (c *PriorityChan[T]) full() bool {
+	return c.Cap > 0 && len(c.heap) >= c.Cap
+}
+
+// before reports whether the value at i is delivered before the value at j.
+func (c *PriorityChan[T]) before(i, j int) bool {
+	a, b := c.heap[i], c.heap[j]
+	if c.Cmp != nil {
+		if n := c.Cmp(a.v, b.v); n != 0 {
+			return n > 0
+		}
+	}
+	return a.seq < b.seq
+}
+
+func (c *PriorityChan[T]) push(v T) {
+	c.heap = append(c.heap, prioItem[T]{v, c.seq})
+	c.seq++
+	for i := len(c.heap) - 1; i > 0; {
+		parent := (i - 1) / 2
+		if !c.before(i, parent) {
+			break
+		}
+		c.heap[i], c.heap[parent] = c.heap[parent], c.heap[i]
+		i = parent
+	}
+}
+
+func (c *PriorityChan[T]) pop() T {
+	n := len(c.heap) - 1
+	v := c.heap[0].v
+	c.heap[0] = c.heap[n]
+	c.heap[n] = prioItem[T]{}
+	c.heap = c.heap[:n]
+	for i := 0; ; {
+		first := i
+		if l := 2*i + 1; l < n && c.before(l, first) {
+			first = l
+		}
+		if r := 2*i + 2; r < n && c.before(r, first) {
+			first = r
+		}
+		if first == i {
+			break
+		}
+		c.heap[i], c.heap[first] = c.heap[first], c.heap[i]
+		i = first
+	}
+	return v
+}
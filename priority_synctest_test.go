@@ -0,0 +1,67 @@
+//go:build go1.25
+
+package zeros
+
+import (
+	"testing"
+	"testing/synctest"
+)
+
+func TestPriorityChanRecvBlocks(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var c PriorityChan[int]
+		var got int
+
+		go func() { got = c.Recv() }()
+		synctest.Wait()
+
+		c.Send(42)
+		synctest.Wait()
+
+		if got != 42 {
+			t.Errorf("PriorityChan[int].Recv() = %d, want 42", got)
+		}
+	})
+}
+
+func TestPriorityChanSendBlocksWhenFull(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		c := PriorityChan[int]{Cap: 1}
+		var sent bool
+
+		c.Send(1)
+		go func() {
+			c.Send(2)
+			sent = true
+		}()
+		synctest.Wait()
+
+		if sent {
+			t.Fatal("PriorityChan[int].Send() at Cap did not block")
+		}
+
+		c.Recv()
+		synctest.Wait()
+
+		if !sent {
+			t.Error("PriorityChan[int].Send() still blocked after Recv()")
+		}
+	})
+}
+
+func TestPriorityChanCloseWakesRecv(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var c PriorityChan[int]
+		ok := true
+
+		go func() { _, ok = c.CheckRecv() }()
+		synctest.Wait()
+
+		c.Close()
+		synctest.Wait()
+
+		if ok {
+			t.Error("PriorityChan[int].CheckRecv() across Close() = true")
+		}
+	})
+}
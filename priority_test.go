@@ -0,0 +1,125 @@
+package zeros
+
+import (
+	"cmp"
+	"slices"
+	"testing"
+)
+
+func drainPriority[T any](c *PriorityChan[T]) []T {
+	var got []T
+	for {
+		v, ok := c.TryRecv()
+		if !ok {
+			return got
+		}
+		got = append(got, v)
+	}
+}
+
+func TestPriorityChanOrder(t *testing.T) {
+	c := PriorityChan[int]{Cmp: cmp.Compare[int]}
+
+	for _, v := range []int{3, 1, 4, 1, 5, 9, 2, 6} {
+		c.Send(v)
+	}
+
+	got := drainPriority(&c)
+	if want := []int{9, 6, 5, 4, 3, 2, 1, 1}; !slices.Equal(got, want) {
+		t.Errorf("PriorityChan[int] received %v, want %v", got, want)
+	}
+}
+
+func TestPriorityChanZeroValueFIFO(t *testing.T) {
+	var c PriorityChan[int]
+
+	for _, v := range []int{3, 1, 2} {
+		c.Send(v)
+	}
+
+	got := drainPriority(&c)
+	if want := []int{3, 1, 2}; !slices.Equal(got, want) {
+		t.Errorf(
+			"PriorityChan[int] with nil Cmp received %v, want %v",
+			got, want,
+		)
+	}
+}
+
+func TestPriorityChanStable(t *testing.T) {
+	type job struct {
+		urgent bool
+		name   string
+	}
+	c := PriorityChan[job]{Cmp: func(a, b job) int {
+		switch {
+		case a.urgent == b.urgent:
+			return 0
+		case a.urgent:
+			return 1
+		default:
+			return -1
+		}
+	}}
+
+	c.Send(job{false, "a"})
+	c.Send(job{true, "b"})
+	c.Send(job{false, "c"})
+	c.Send(job{true, "d"})
+
+	var got []string
+	for _, j := range drainPriority(&c) {
+		got = append(got, j.name)
+	}
+	if want := []string{"b", "d", "a", "c"}; !slices.Equal(got, want) {
+		t.Errorf("PriorityChan[job] received %v, want %v", got, want)
+	}
+}
+
+func TestPriorityChanCap(t *testing.T) {
+	c := PriorityChan[int]{Cap: 2}
+
+	if !c.TrySend(1) || !c.TrySend(2) {
+		t.Fatal("PriorityChan[int].TrySend() below Cap = false, want true")
+	}
+	if c.TrySend(3) {
+		t.Error("PriorityChan[int].TrySend() at Cap = true, want false")
+	}
+	if got := c.Len(); got != 2 {
+		t.Errorf("PriorityChan[int].Len() = %d, want 2", got)
+	}
+}
+
+func TestPriorityChanClose(t *testing.T) {
+	var c PriorityChan[int]
+
+	c.Send(42)
+	c.Close()
+	c.Close()
+
+	if got, ok := c.CheckRecv(); !ok || got != 42 {
+		t.Errorf(
+			"PriorityChan[int].CheckRecv() after Close() = %d, %v, "+
+				"want 42, true",
+			got, ok,
+		)
+	}
+	if _, ok := c.CheckRecv(); ok {
+		t.Error(
+			"PriorityChan[int].CheckRecv() on drained closed channel = true",
+		)
+	}
+}
+
+func TestPriorityChanSendClosed(t *testing.T) {
+	var c PriorityChan[int]
+
+	c.Close()
+
+	defer func() {
+		if recover() == nil {
+			t.Error("PriorityChan[int].Send() after Close() did not panic")
+		}
+	}()
+	c.Send(42)
+}
@@ -0,0 +1,72 @@
+package zeros
+
+import (
+	"iter"
+	"sync"
+)
+
+// ProducerChan is a zero-valueable Chan that closes itself once every
+// registered producer is done.
+//
+// Register each producer with AddProducer before it starts sending.
+// Send, TrySend, and SendAll panic with a descriptive message if called
+// after the channel has closed.
+type ProducerChan[T any] struct {
+	Chan[T]
+	mu        sync.Mutex
+	producers int
+}
+
+const producerSendPanic = "zeros: send on ProducerChan after all producers " +
+	"are done"
+
+// AddProducer registers a producer and returns a function that marks it
+// done. Once every registered producer is done, the channel is closed.
+// The returned function may be called more than once.
+//
+// AddProducer panics if the channel is closed.
+func (c *ProducerChan[T]) AddProducer() (done func()) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.Closed() {
+		panic("zeros: AddProducer on closed ProducerChan")
+	}
+	c.producers++
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			c.mu.Lock()
+			defer c.mu.Unlock()
+			if c.producers--; c.producers == 0 {
+				c.Close()
+			}
+		})
+	}
+}
+
+// Send sends a value on the channel, blocking until the value is sent.
+// It panics if the channel is closed.
+func (c *ProducerChan[T]) Send(v T) {
+	if err := c.CheckSend(v); err != nil {
+		panic(producerSendPanic)
+	}
+}
+
+// TrySend attempts to send a value on the channel without blocking.
+// It reports whether the value was sent.
+// It panics if the channel is closed.
+func (c *ProducerChan[T]) TrySend(v T) bool {
+	ok, err := c.trySend(v)
+	if err != nil {
+		panic(producerSendPanic)
+	}
+	return ok
+}
+
+// SendAll sends every value from seq on the channel, blocking on each send.
+// It panics if the channel is closed.
+func (c *ProducerChan[T]) SendAll(seq iter.Seq[T]) {
+	for v := range seq {
+		c.Send(v)
+	}
+}
@@ -0,0 +1,29 @@
+//go:build go1.25
+
+package zeros
+
+import (
+	"slices"
+	"testing"
+	"testing/synctest"
+)
+
+func TestProducerChanConcurrent(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var c ProducerChan[int]
+
+		for i := range 5 {
+			done := c.AddProducer()
+			go func() {
+				defer done()
+				c.SendAll(slices.Values([]int{i * 2, i*2 + 1}))
+			}()
+		}
+
+		got := slices.Sorted(c.All())
+		want := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+		if !slices.Equal(got, want) {
+			t.Errorf("ProducerChan[int] received %v, want %v", got, want)
+		}
+	})
+}
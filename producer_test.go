@@ -0,0 +1,75 @@
+package zeros
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestProducerChanCloses(t *testing.T) {
+	var c ProducerChan[int]
+	c.SetCap(2)
+
+	done1, done2 := c.AddProducer(), c.AddProducer()
+	c.Send(1)
+	done1()
+	done1()
+
+	if c.Closed() {
+		t.Fatal("ProducerChan[int] closed with a producer remaining")
+	}
+
+	c.Send(2)
+	done2()
+
+	if !c.Closed() {
+		t.Fatal("ProducerChan[int] open after all producers done")
+	}
+	for _, want := range []int{1, 2} {
+		if got, ok := c.CheckRecv(); !ok || got != want {
+			t.Errorf(
+				"ProducerChan[int].CheckRecv() = %d, %v, want %d, true",
+				got, ok, want,
+			)
+		}
+	}
+}
+
+func TestProducerChanSendAfterDone(t *testing.T) {
+	var c ProducerChan[int]
+
+	c.AddProducer()()
+
+	defer func() {
+		msg, _ := recover().(string)
+		if !strings.Contains(msg, "all producers are done") {
+			t.Errorf("ProducerChan[int].Send() after close panicked %q", msg)
+		}
+	}()
+	c.Send(42)
+}
+
+func TestProducerChanTrySendAfterDone(t *testing.T) {
+	var c ProducerChan[int]
+
+	c.AddProducer()()
+
+	defer func() {
+		if recover() == nil {
+			t.Error("ProducerChan[int].TrySend() after close did not panic")
+		}
+	}()
+	c.TrySend(42)
+}
+
+func TestProducerChanAddAfterDone(t *testing.T) {
+	var c ProducerChan[int]
+
+	c.AddProducer()()
+
+	defer func() {
+		if recover() == nil {
+			t.Error("ProducerChan[int].AddProducer() after close did not panic")
+		}
+	}()
+	c.AddProducer()
+}
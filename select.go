@@ -0,0 +1,154 @@
+package zeros
+
+import (
+	"context"
+	"reflect"
+)
+
+// SelectCase is a case of a Select, created by OnRecv or OnSend.
+type SelectCase struct {
+	dir  reflect.SelectDir
+	ch   func() reflect.Value // initializes the channel on first use
+	send reflect.Value
+	recv func(reflect.Value)
+	sent func()
+}
+
+// OnRecv returns a case that receives a value from c and passes it to f.
+// Once c is closed, the case is disabled.
+// If f is nil, received values are discarded.
+func OnRecv[T any](c *Chan[T], f func(T)) SelectCase {
+	return SelectCase{
+		dir: reflect.SelectRecv,
+		ch:  func() reflect.Value { return reflect.ValueOf(c.Chan()) },
+		recv: func(rv reflect.Value) {
+			if f == nil {
+				return
+			}
+			var v T
+			reflect.ValueOf(&v).Elem().Set(rv)
+			f(v)
+		},
+	}
+}
+
+// OnSend returns a case that sends v on c and then calls f.
+// Like Chan.Send, the case panics if c is closed.
+// If f is nil, nothing is called after the send.
+func OnSend[T any](c *Chan[T], v T, f func()) SelectCase {
+	return SelectCase{
+		dir:  reflect.SelectSend,
+		ch:   func() reflect.Value { return reflect.ValueOf(c.Chan()) },
+		send: reflect.ValueOf(&v).Elem(),
+		sent: f,
+	}
+}
+
+// Selector performs a select over a dynamic set of cases.
+// Create one with Select.
+//
+// Because the cases are built by the generic functions OnRecv and OnSend,
+// a single Selector may mix channels of different element types.
+type Selector struct {
+	cases    []SelectCase
+	closed   []bool // receive cases whose channel has been closed
+	ctx      context.Context
+	fallback func()
+}
+
+// Select returns a Selector over cases.
+func Select(cases ...SelectCase) *Selector {
+	return &Selector{cases: cases, closed: make([]bool, len(cases))}
+}
+
+// Ctx makes the Selector stop waiting once ctx is done.
+func (s *Selector) Ctx(ctx context.Context) *Selector {
+	s.ctx = ctx
+	return s
+}
+
+// Default adds a default case that calls f when no other case is ready.
+func (s *Selector) Default(f func()) *Selector {
+	s.fallback = f
+	return s
+}
+
+// Do blocks until one case can proceed and runs it, or runs the default
+// case if one is set and no other case is ready.
+//
+// It returns ctx.Err() if the context passed to Ctx is done, and
+// ErrClosed if every case has been disabled because its channel is
+// closed.
+func (s *Selector) Do() error {
+	for {
+		var (
+			rcs     []reflect.SelectCase
+			indexes []int
+		)
+		for i, c := range s.cases {
+			if s.closed[i] {
+				continue
+			}
+			rcs = append(rcs, reflect.SelectCase{
+				Dir:  c.dir,
+				Chan: c.ch(),
+				Send: c.send,
+			})
+			indexes = append(indexes, i)
+		}
+		if len(rcs) == 0 {
+			return ErrClosed
+		}
+		ctxCase, defaultCase := -1, -1
+		if s.ctx != nil {
+			ctxCase = len(rcs)
+			rcs = append(rcs, reflect.SelectCase{
+				Dir:  reflect.SelectRecv,
+				Chan: reflect.ValueOf(s.ctx.Done()),
+			})
+		}
+		if s.fallback != nil {
+			defaultCase = len(rcs)
+			rcs = append(rcs, reflect.SelectCase{Dir: reflect.SelectDefault})
+		}
+
+		chosen, v, ok := reflect.Select(rcs)
+		switch chosen {
+		case ctxCase:
+			return s.ctx.Err()
+		case defaultCase:
+			s.fallback()
+			return nil
+		}
+		i := indexes[chosen]
+		c := s.cases[i]
+		if c.dir == reflect.SelectSend {
+			if c.sent != nil {
+				c.sent()
+			}
+			return nil
+		}
+		if !ok {
+			s.closed[i] = true
+			continue
+		}
+		c.recv(v)
+		return nil
+	}
+}
+
+// Loop runs Do repeatedly until every case is disabled, returning nil,
+// or until the context passed to Ctx is done, returning ctx.Err().
+//
+// A Selector with a default case never blocks, so its Loop spins until
+// the context is done or every case is disabled.
+func (s *Selector) Loop() error {
+	for {
+		if err := s.Do(); err != nil {
+			if err == ErrClosed {
+				return nil
+			}
+			return err
+		}
+	}
+}
@@ -0,0 +1,80 @@
+//go:build go1.25
+
+package zeros
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"testing/synctest"
+	"time"
+)
+
+func TestSelectLoop(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var ints Chan[int]
+		var strs Chan[string]
+
+		go func() {
+			ints.SendAll(slices.Values([]int{1, 2}))
+			ints.Close()
+		}()
+		go func() {
+			strs.SendAll(slices.Values([]string{"a", "b"}))
+			strs.Close()
+		}()
+
+		var gotInts []int
+		var gotStrs []string
+		err := Select(
+			OnRecv(&ints, func(v int) { gotInts = append(gotInts, v) }),
+			OnRecv(&strs, func(v string) { gotStrs = append(gotStrs, v) }),
+		).Loop()
+
+		if err != nil {
+			t.Errorf("Select().Loop() = %v, want nil", err)
+		}
+		if want := []int{1, 2}; !slices.Equal(gotInts, want) {
+			t.Errorf("Select().Loop() received ints %v, want %v", gotInts, want)
+		}
+		if want := []string{"a", "b"}; !slices.Equal(gotStrs, want) {
+			t.Errorf("Select().Loop() received strs %v, want %v", gotStrs, want)
+		}
+	})
+}
+
+func TestSelectLoopCtx(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var a Chan[int]
+		ctx, cancel := context.WithTimeout(t.Context(), time.Second)
+		defer cancel()
+
+		err := Select(OnRecv(&a, nil)).Ctx(ctx).Loop()
+
+		if err != context.DeadlineExceeded {
+			t.Errorf(
+				"Select().Ctx(ctx).Loop() past deadline = %v, want %v",
+				err, context.DeadlineExceeded,
+			)
+		}
+	})
+}
+
+func TestSelectLazyInit(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var a Chan[int]
+		var got int
+
+		go func() {
+			Select(OnRecv(&a, func(v int) { got = v })).Do()
+		}()
+		synctest.Wait()
+
+		a.Send(42)
+		synctest.Wait()
+
+		if got != 42 {
+			t.Errorf("Select(OnRecv()).Do() received %d, want 42", got)
+		}
+	})
+}
@@ -0,0 +1,115 @@
+package zeros
+
+import (
+	"context"
+	"testing"
+)
+
+func TestSelectRecv(t *testing.T) {
+	var a Chan[int]
+	var b Chan[string]
+	a.SetCap(1)
+	b.SetCap(1)
+
+	b.Send("hello")
+
+	var got string
+	err := Select(
+		OnRecv(&a, func(int) { t.Error("received from empty channel") }),
+		OnRecv(&b, func(v string) { got = v }),
+	).Do()
+
+	if err != nil || got != "hello" {
+		t.Errorf(
+			"Select().Do() received %q, %v, want %q, nil",
+			got, err, "hello",
+		)
+	}
+}
+
+func TestSelectSend(t *testing.T) {
+	var a Chan[int]
+	a.SetCap(1)
+
+	var sent bool
+	if err := Select(OnSend(&a, 42, func() { sent = true })).Do(); err != nil {
+		t.Fatalf("Select(OnSend()).Do() = %v, want nil", err)
+	}
+
+	if !sent {
+		t.Error("OnSend callback not called")
+	}
+	if got := a.Recv(); got != 42 {
+		t.Errorf("Chan[int].Recv() after OnSend = %d, want 42", got)
+	}
+}
+
+func TestSelectDefault(t *testing.T) {
+	var a Chan[int]
+
+	var called bool
+	err := Select(OnRecv(&a, nil)).Default(func() { called = true }).Do()
+
+	if err != nil || !called {
+		t.Errorf(
+			"Select().Default().Do() on empty channel: err = %v, "+
+				"default called = %v, want nil, true",
+			err, called,
+		)
+	}
+}
+
+func TestSelectCtx(t *testing.T) {
+	var a Chan[int]
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := Select(OnRecv(&a, nil)).Ctx(ctx).Do()
+
+	if err != context.Canceled {
+		t.Errorf(
+			"Select().Ctx(canceled).Do() = %v, want %v",
+			err, context.Canceled,
+		)
+	}
+}
+
+func TestSelectClosed(t *testing.T) {
+	var a, b Chan[int]
+	b.SetCap(1)
+
+	a.Close()
+	b.Send(42)
+
+	var got int
+	s := Select(OnRecv(&a, nil), OnRecv(&b, func(v int) { got = v }))
+	if err := s.Do(); err != nil || got != 42 {
+		t.Errorf("Select().Do() = %v, received %d, want nil, 42", err, got)
+	}
+
+	b.Close()
+	if err := s.Do(); err != ErrClosed {
+		t.Errorf(
+			"Select().Do() with all channels closed = %v, want %v",
+			err, ErrClosed,
+		)
+	}
+}
+
+func TestSelectNilInterface(t *testing.T) {
+	var a Chan[error]
+	a.SetCap(1)
+
+	a.Send(nil)
+
+	var called bool
+	err := Select(OnRecv(&a, func(err error) { called = err == nil })).Do()
+
+	if err != nil || !called {
+		t.Errorf(
+			"Select().Do() with nil interface value: err = %v, "+
+				"callback saw nil = %v, want nil, true",
+			err, called,
+		)
+	}
+}
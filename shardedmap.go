@@ -0,0 +1,164 @@
+package zeros
+
+import (
+	"hash/maphash"
+	"iter"
+	"runtime"
+)
+
+// ShardedMap is a zero-valueable map that is safe for concurrent use and
+// scales under contention. It has the same methods as SyncMap.
+//
+// Keys are hashed with hash/maphash into shards, each guarded by its own
+// lock. The shards are allocated on first use.
+//
+// GetOrSet, GetOrCompute, Update, Swap, and CompareAndSwap are atomic,
+// as for SyncMap.
+//
+// Len, Clear, and the iterators visit shards one at a time, so they do
+// not observe the map at a single point in time. Each shard is iterated
+// over a snapshot taken when the iterator reaches it, and the map may be
+// modified during iteration.
+type ShardedMap[K comparable, V any] struct {
+	once OnceValue[*shards[K, V]]
+}
+
+type shards[K comparable, V any] struct {
+	seed   maphash.Seed
+	shards []shard[K, V]
+}
+
+type shard[K comparable, V any] struct {
+	SyncMap[K, V]
+	_ [64]byte // keep neighboring locks off the same cache line
+}
+
+func (m *ShardedMap[K, V]) init() *shards[K, V] {
+	return m.once.Do(func() *shards[K, V] {
+		// Use a power of two comfortably above the number of CPUs so
+		// that a shard can be picked with a mask.
+		n := 1
+		for n < 4*runtime.GOMAXPROCS(0) {
+			n <<= 1
+		}
+		return &shards[K, V]{
+			seed:   maphash.MakeSeed(),
+			shards: make([]shard[K, V], n),
+		}
+	})
+}
+
+func (m *ShardedMap[K, V]) shard(key K) *SyncMap[K, V] {
+	s := m.init()
+	h := maphash.Comparable(s.seed, key)
+	return &s.shards[h&uint64(len(s.shards)-1)].SyncMap
+}
+
+// Set sets a key-value pair.
+func (m *ShardedMap[K, V]) Set(key K, value V) { m.shard(key).Set(key, value) }
+
+// Get retrieves a value by key, returning the zero value if not found.
+func (m *ShardedMap[K, V]) Get(key K) V { return m.shard(key).Get(key) }
+
+// CheckGet retrieves a value by key with a presence indicator.
+func (m *ShardedMap[K, V]) CheckGet(key K) (V, bool) {
+	return m.shard(key).CheckGet(key)
+}
+
+// Delete removes a key.
+func (m *ShardedMap[K, V]) Delete(key K) { m.shard(key).Delete(key) }
+
+// GetOrSet returns the existing value for key if present.
+// Otherwise, it sets key to value and returns value.
+// The boolean result reports whether the value was already present.
+func (m *ShardedMap[K, V]) GetOrSet(key K, value V) (V, bool) {
+	return m.shard(key).GetOrSet(key, value)
+}
+
+// GetOrCompute returns the existing value for key if present.
+// Otherwise, it sets key to the result of f and returns it.
+// The boolean result reports whether the value was already present.
+//
+// f is called at most once per absent key, with the key's shard locked,
+// so it must not use the map.
+func (m *ShardedMap[K, V]) GetOrCompute(key K, f func() V) (V, bool) {
+	return m.shard(key).GetOrCompute(key, f)
+}
+
+// Update calls f with the current value for key and whether it is
+// present. If f returns true, key is set to the returned value;
+// otherwise, key is deleted. Update returns the results of f.
+//
+// f is called with the key's shard locked, so it must not use the map.
+func (m *ShardedMap[K, V]) Update(
+	key K, f func(old V, ok bool) (V, bool),
+) (V, bool) {
+	return m.shard(key).Update(key, f)
+}
+
+// Swap sets key to value and returns the previous value, if any.
+// The boolean result reports whether key was present.
+func (m *ShardedMap[K, V]) Swap(key K, value V) (V, bool) {
+	return m.shard(key).Swap(key, value)
+}
+
+// CompareAndSwap sets key to new if key is present and its value is equal
+// to old. It reports whether the value was swapped.
+// Like sync.Map.CompareAndSwap, it panics if V is not comparable.
+func (m *ShardedMap[K, V]) CompareAndSwap(key K, old, new V) bool {
+	return m.shard(key).CompareAndSwap(key, old, new)
+}
+
+// Len returns the number of elements.
+func (m *ShardedMap[K, V]) Len() int {
+	s := m.init()
+	var n int
+	for i := range s.shards {
+		n += s.shards[i].Len()
+	}
+	return n
+}
+
+// Keys returns an iterator over keys in the map.
+func (m *ShardedMap[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range m.All() {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over values in the map.
+func (m *ShardedMap[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range m.All() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over key-value pairs in the map.
+func (m *ShardedMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		s := m.init()
+		for i := range s.shards {
+			for k, v := range s.shards[i].All() {
+				if !yield(k, v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Clear removes all elements from the map.
+func (m *ShardedMap[K, V]) Clear() {
+	s := m.init()
+	for i := range s.shards {
+		s.shards[i].Clear()
+	}
+}
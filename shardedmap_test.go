@@ -0,0 +1,202 @@
+package zeros
+
+import (
+	"maps"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+func TestShardedMapZeroValue(t *testing.T) {
+	var m ShardedMap[string, int]
+
+	m.Set("answer", 42)
+
+	if got, ok := m.CheckGet("answer"); !ok || got != 42 {
+		t.Errorf(
+			"ShardedMap[string, int].CheckGet(%q) = %d, %v, want 42, true",
+			"answer", got, ok,
+		)
+	}
+	if got := m.Get("missing"); got != 0 {
+		t.Errorf(
+			"ShardedMap[string, int].Get(%q) = %d, want 0",
+			"missing", got,
+		)
+	}
+}
+
+func TestShardedMapAll(t *testing.T) {
+	var m ShardedMap[string, int]
+
+	want := make(map[string]int)
+	for i := range 100 {
+		k := strconv.Itoa(i)
+		m.Set(k, i)
+		want[k] = i
+	}
+
+	if got := maps.Collect(m.All()); !maps.Equal(got, want) {
+		t.Errorf("maps.Collect(m.All()) = %v, want %v", got, want)
+	}
+	if got := m.Len(); got != 100 {
+		t.Errorf("ShardedMap[string, int].Len() = %d, want 100", got)
+	}
+
+	var keys, values int
+	for range m.Keys() {
+		keys++
+	}
+	for range m.Values() {
+		values++
+	}
+	if keys != 100 || values != 100 {
+		t.Errorf(
+			"range m.Keys(), m.Values() saw %d, %d entries, want 100, 100",
+			keys, values,
+		)
+	}
+}
+
+func TestShardedMapDeleteClear(t *testing.T) {
+	var m ShardedMap[int, int]
+
+	for i := range 10 {
+		m.Set(i, i)
+	}
+	m.Delete(3)
+
+	if _, ok := m.CheckGet(3); ok {
+		t.Error("ShardedMap[int, int].CheckGet(3) after Delete(3) = true")
+	}
+	if got := m.Len(); got != 9 {
+		t.Errorf("ShardedMap[int, int].Len() after Delete = %d, want 9", got)
+	}
+
+	m.Clear()
+
+	if got := m.Len(); got != 0 {
+		t.Errorf("ShardedMap[int, int].Len() after Clear() = %d, want 0", got)
+	}
+}
+
+func TestShardedMapAllStop(t *testing.T) {
+	var m ShardedMap[int, int]
+
+	for i := range 10 {
+		m.Set(i, i)
+	}
+
+	var n int
+	for range m.All() {
+		n++
+		if n >= 2 {
+			break
+		}
+	}
+
+	if n != 2 {
+		t.Errorf("range m.All() with break iterated %d times, want 2", n)
+	}
+}
+
+// benchMap is the subset of the map API exercised by the benchmarks.
+type benchMap interface {
+	Set(key, value int)
+	Get(key int) int
+}
+
+type syncMapBench struct{ m sync.Map }
+
+func (b *syncMapBench) Set(key, value int) { b.m.Store(key, value) }
+
+func (b *syncMapBench) Get(key int) int {
+	v, _ := b.m.Load(key)
+	n, _ := v.(int)
+	return n
+}
+
+type mutexMapBench struct {
+	mu sync.RWMutex
+	m  Map[int, int]
+}
+
+func (b *mutexMapBench) Set(key, value int) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.m.Set(key, value)
+}
+
+func (b *mutexMapBench) Get(key int) int {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return b.m.Get(key)
+}
+
+// benchmarkMap runs a workload that writes once every writeEvery
+// operations and reads otherwise.
+func benchmarkMap(b *testing.B, writeEvery int) {
+	const keys = 1 << 10
+	impls := []struct {
+		name string
+		new  func() benchMap
+	}{
+		{"ShardedMap", func() benchMap { return new(ShardedMap[int, int]) }},
+		{"SyncMap", func() benchMap { return new(SyncMap[int, int]) }},
+		{"sync.Map", func() benchMap { return new(syncMapBench) }},
+		{"MutexMap", func() benchMap { return new(mutexMapBench) }},
+	}
+	for _, impl := range impls {
+		b.Run(impl.name, func(b *testing.B) {
+			m := impl.new()
+			for i := range keys {
+				m.Set(i, i)
+			}
+			b.ResetTimer()
+			b.RunParallel(func(pb *testing.PB) {
+				var i int
+				for pb.Next() {
+					if i%writeEvery == 0 {
+						m.Set(i%keys, i)
+					} else {
+						m.Get(i % keys)
+					}
+					i++
+				}
+			})
+		})
+	}
+}
+
+func BenchmarkMapReadMostly(b *testing.B) { benchmarkMap(b, 100) }
+
+func BenchmarkMapMixed(b *testing.B) { benchmarkMap(b, 4) }
+
+func BenchmarkMapWriteHeavy(b *testing.B) { benchmarkMap(b, 1) }
+
+func TestShardedMapUpdate(t *testing.T) {
+	var m ShardedMap[int, int]
+
+	for i := range 10 {
+		m.GetOrSet(i, i)
+		m.Update(i, func(old int, _ bool) (int, bool) {
+			return old * 2, old%2 == 0
+		})
+	}
+
+	if got := m.Len(); got != 5 {
+		t.Errorf("ShardedMap[int, int].Len() after Update = %d, want 5", got)
+	}
+	if prev, loaded := m.Swap(4, 1); prev != 8 || !loaded {
+		t.Errorf(
+			"ShardedMap[int, int].Swap(4, 1) = %d, %t, want 8, true",
+			prev, loaded,
+		)
+	}
+	if !m.CompareAndSwap(4, 1, 2) {
+		t.Error("ShardedMap[int, int].CompareAndSwap(4, 1, 2) = false")
+	}
+	if v, _ := m.GetOrCompute(4, func() int { return 0 }); v != 2 {
+		t.Errorf("ShardedMap[int, int].GetOrCompute(4, f) = %d, want 2", v)
+	}
+}
@@ -0,0 +1,63 @@
+package zeros
+
+import (
+	"bytes"
+	"net/http"
+)
+
+// SSEHandler returns an http.Handler that streams values received from c
+// to the client as Server-Sent Events, flushing after each event.
+//
+// Each value is encoded with encode and written as the data of one event;
+// multi-line data is split across data fields. The stream ends when the
+// request context is done, c is closed, or encode returns an error.
+//
+// Each value is delivered to only one client, so concurrent requests
+// share the stream rather than each receiving every value.
+func SSEHandler[T any](
+	c *Chan[T], encode func(T) ([]byte, error),
+) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		rc := http.NewResponseController(w)
+		h := w.Header()
+		h.Set("Content-Type", "text/event-stream")
+		h.Set("Cache-Control", "no-cache")
+		w.WriteHeader(http.StatusOK)
+		if err := rc.Flush(); err != nil {
+			return
+		}
+		var buf bytes.Buffer
+		for {
+			v, err := c.RecvCtx(r.Context())
+			if err != nil {
+				return
+			}
+			data, err := encode(v)
+			if err != nil {
+				return
+			}
+			buf.Reset()
+			writeEvent(&buf, data)
+			if _, err := w.Write(buf.Bytes()); err != nil {
+				return
+			}
+			if err := rc.Flush(); err != nil {
+				return
+			}
+		}
+	})
+}
+
+// writeEvent writes data to buf as a single Server-Sent Event.
+// Every line break the spec recognizes (CRLF, CR, or LF) starts a new
+// data field, so data cannot inject other fields.
+func writeEvent(buf *bytes.Buffer, data []byte) {
+	data = bytes.ReplaceAll(data, []byte("\r\n"), []byte("\n"))
+	data = bytes.ReplaceAll(data, []byte("\r"), []byte("\n"))
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		buf.WriteString("data: ")
+		buf.Write(line)
+		buf.WriteByte('\n')
+	}
+	buf.WriteByte('\n')
+}
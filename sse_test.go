@@ -0,0 +1,124 @@
+package zeros
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+func TestSSEHandler(t *testing.T) {
+	var c Chan[string]
+	c.SetCap(2)
+
+	c.Send("hello")
+	c.Send("multi\nline")
+	c.Close()
+
+	encode := func(s string) ([]byte, error) { return []byte(s), nil }
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/events", nil)
+	SSEHandler(&c, encode).ServeHTTP(w, r)
+
+	ct := w.Header().Get("Content-Type")
+	if want := "text/event-stream"; ct != want {
+		t.Errorf("Content-Type = %q, want %q", ct, want)
+	}
+	if !w.Flushed {
+		t.Error("SSEHandler did not flush")
+	}
+	want := "data: hello\n\ndata: multi\ndata: line\n\n"
+	if got := w.Body.String(); got != want {
+		t.Errorf("SSEHandler body = %q, want %q", got, want)
+	}
+}
+
+func TestSSEHandlerLineBreaks(t *testing.T) {
+	var c Chan[string]
+	c.SetCap(2)
+
+	c.Send("hello\revent: admin")
+	c.Send("crlf\r\nline")
+	c.Close()
+
+	encode := func(s string) ([]byte, error) { return []byte(s), nil }
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/events", nil)
+	SSEHandler(&c, encode).ServeHTTP(w, r)
+
+	want := "data: hello\ndata: event: admin\n\ndata: crlf\ndata: line\n\n"
+	if got := w.Body.String(); got != want {
+		t.Errorf("SSEHandler body = %q, want %q", got, want)
+	}
+}
+
+func TestSSEHandlerCanceled(t *testing.T) {
+	var c Chan[int]
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	encode := func(v int) ([]byte, error) {
+		return []byte(strconv.Itoa(v)), nil
+	}
+	w := httptest.NewRecorder()
+	r := httptest.NewRequestWithContext(ctx, http.MethodGet, "/events", nil)
+	SSEHandler(&c, encode).ServeHTTP(w, r)
+
+	if got := w.Body.String(); got != "" {
+		t.Errorf("SSEHandler body with canceled request = %q, want empty", got)
+	}
+}
+
+func TestSSEHandlerEncodeError(t *testing.T) {
+	var c Chan[int]
+	c.SetCap(2)
+
+	c.Send(1)
+	c.Send(2)
+
+	encode := func(v int) ([]byte, error) {
+		if v == 2 {
+			return nil, errors.New("bad value")
+		}
+		return []byte(strconv.Itoa(v)), nil
+	}
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest(http.MethodGet, "/events", nil)
+	SSEHandler(&c, encode).ServeHTTP(w, r)
+
+	if got, want := w.Body.String(), "data: 1\n\n"; got != want {
+		t.Errorf("SSEHandler body = %q, want %q", got, want)
+	}
+}
+
+func TestSSEHandlerServer(t *testing.T) {
+	var c Chan[string]
+	encode := func(s string) ([]byte, error) { return []byte(s), nil }
+	srv := httptest.NewServer(SSEHandler(&c, encode))
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL)
+	if err != nil {
+		t.Fatalf("GET %s: %v", srv.URL, err)
+	}
+	defer resp.Body.Close()
+
+	// The event arrives before the channel closes, so it must have been
+	// flushed.
+	c.Send("hello")
+	br := bufio.NewReader(resp.Body)
+	for _, want := range []string{"data: hello\n", "\n"} {
+		if got, err := br.ReadString('\n'); got != want || err != nil {
+			t.Fatalf("read %q, %v, want %q, nil", got, err, want)
+		}
+	}
+
+	c.Close()
+	if _, err := br.ReadByte(); err != io.EOF {
+		t.Errorf("read after Close() = %v, want %v", err, io.EOF)
+	}
+}
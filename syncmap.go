@@ -0,0 +1,168 @@
+package zeros
+
+import (
+	"iter"
+	"sync"
+)
+
+// SyncMap is a zero-valueable map that is safe for concurrent use.
+// It has the same methods as Map, guarded by a sync.RWMutex.
+//
+// GetOrSet, GetOrCompute, Update, Swap, and CompareAndSwap are atomic:
+// each holds the lock across its read and write.
+//
+// Keys, Values, and All iterate over a snapshot taken when iteration
+// begins. The map may be modified during iteration, including from the
+// loop body, but the iterator does not observe those modifications.
+type SyncMap[K comparable, V any] struct {
+	mu sync.RWMutex
+	m  Map[K, V]
+}
+
+// Set sets a key-value pair.
+func (m *SyncMap[K, V]) Set(key K, value V) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.m.Set(key, value)
+}
+
+// Get retrieves a value by key, returning the zero value if not found.
+func (m *SyncMap[K, V]) Get(key K) V {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.m.Get(key)
+}
+
+// CheckGet retrieves a value by key with a presence indicator.
+func (m *SyncMap[K, V]) CheckGet(key K) (V, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.m.CheckGet(key)
+}
+
+// Delete removes a key.
+func (m *SyncMap[K, V]) Delete(key K) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.m.Delete(key)
+}
+
+// Len returns the number of elements.
+func (m *SyncMap[K, V]) Len() int {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.m.Len()
+}
+
+// Keys returns an iterator over a snapshot of the keys in the map.
+func (m *SyncMap[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range m.All() {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over a snapshot of the values in the map.
+func (m *SyncMap[K, V]) Values() iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range m.All() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// All returns an iterator over a snapshot of the key-value pairs in the
+// map.
+func (m *SyncMap[K, V]) All() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for _, e := range m.snapshot() {
+			if !yield(e.key, e.value) {
+				return
+			}
+		}
+	}
+}
+
+type mapEntry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+func (m *SyncMap[K, V]) snapshot() []mapEntry[K, V] {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	entries := make([]mapEntry[K, V], 0, m.m.Len())
+	for k, v := range m.m.All() {
+		entries = append(entries, mapEntry[K, V]{k, v})
+	}
+	return entries
+}
+
+// Clear removes all elements from the map.
+func (m *SyncMap[K, V]) Clear() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.m.Clear()
+}
+
+// GetOrSet returns the existing value for key if present.
+// Otherwise, it sets key to value and returns value.
+// The boolean result reports whether the value was already present.
+func (m *SyncMap[K, V]) GetOrSet(key K, value V) (V, bool) {
+	if v, ok := m.CheckGet(key); ok {
+		return v, true
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.m.GetOrSet(key, value)
+}
+
+// GetOrCompute returns the existing value for key if present.
+// Otherwise, it sets key to the result of f and returns it.
+// The boolean result reports whether the value was already present.
+//
+// f is called at most once per absent key, with the map locked, so it
+// must not use the map.
+func (m *SyncMap[K, V]) GetOrCompute(key K, f func() V) (V, bool) {
+	if v, ok := m.CheckGet(key); ok {
+		return v, true
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.m.GetOrCompute(key, f)
+}
+
+// Update calls f with the current value for key and whether it is
+// present. If f returns true, key is set to the returned value;
+// otherwise, key is deleted. Update returns the results of f.
+//
+// f is called with the map locked, so it must not use the map.
+func (m *SyncMap[K, V]) Update(
+	key K, f func(old V, ok bool) (V, bool),
+) (V, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.m.Update(key, f)
+}
+
+// Swap sets key to value and returns the previous value, if any.
+// The boolean result reports whether key was present.
+func (m *SyncMap[K, V]) Swap(key K, value V) (V, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.m.Swap(key, value)
+}
+
+// CompareAndSwap sets key to new if key is present and its value is equal
+// to old. It reports whether the value was swapped.
+// Like sync.Map.CompareAndSwap, it panics if V is not comparable.
+func (m *SyncMap[K, V]) CompareAndSwap(key K, old, new V) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.m.CompareAndSwap(key, old, new)
+}
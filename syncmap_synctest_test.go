@@ -0,0 +1,58 @@
+//go:build go1.25
+
+package zeros
+
+import (
+	"sync"
+	"testing"
+	"testing/synctest"
+)
+
+func TestSyncMapConcurrent(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var m SyncMap[int, int]
+		var wg sync.WaitGroup
+
+		for i := range 10 {
+			wg.Go(func() {
+				m.Set(i, i*i)
+				m.Get(i)
+				for range m.All() {
+				}
+			})
+		}
+		wg.Wait()
+
+		if got := m.Len(); got != 10 {
+			t.Errorf("SyncMap[int, int].Len() = %d, want 10", got)
+		}
+	})
+}
+
+func TestSyncMapAtomicUpdate(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var m SyncMap[string, int]
+		var calls int
+		var wg sync.WaitGroup
+
+		for range 100 {
+			wg.Go(func() {
+				m.GetOrCompute("init", func() int {
+					calls++
+					return 1
+				})
+				m.Update("n", func(old int, _ bool) (int, bool) {
+					return old + 1, true
+				})
+			})
+		}
+		wg.Wait()
+
+		if calls != 1 {
+			t.Errorf("GetOrCompute called f %d times, want 1", calls)
+		}
+		if got := m.Get("n"); got != 100 {
+			t.Errorf("SyncMap[string, int].Get(\"n\") = %d, want 100", got)
+		}
+	})
+}
@@ -0,0 +1,142 @@
+package zeros
+
+import (
+	"maps"
+	"testing"
+)
+
+func TestSyncMapZeroValue(t *testing.T) {
+	var m SyncMap[string, int]
+
+	m.Set("answer", 42)
+
+	if got, ok := m.CheckGet("answer"); !ok || got != 42 {
+		t.Errorf(
+			"SyncMap[string, int].CheckGet(%q) = %d, %v, want 42, true",
+			"answer", got, ok,
+		)
+	}
+	if got := m.Get("missing"); got != 0 {
+		t.Errorf("SyncMap[string, int].Get(%q) = %d, want 0", "missing", got)
+	}
+}
+
+func TestSyncMapDeleteLenClear(t *testing.T) {
+	var m SyncMap[string, int]
+
+	m.Set("a", 1)
+	m.Set("b", 2)
+	m.Set("c", 3)
+	m.Delete("a")
+
+	if got := m.Len(); got != 2 {
+		t.Errorf("SyncMap[string, int].Len() after Delete = %d, want 2", got)
+	}
+
+	m.Clear()
+
+	if got := m.Len(); got != 0 {
+		t.Errorf("SyncMap[string, int].Len() after Clear() = %d, want 0", got)
+	}
+}
+
+func TestSyncMapIterators(t *testing.T) {
+	var m SyncMap[string, int]
+
+	m.Set("a", 1)
+	m.Set("b", 2)
+
+	want := map[string]int{"a": 1, "b": 2}
+	if got := maps.Collect(m.All()); !maps.Equal(got, want) {
+		t.Errorf("maps.Collect(m.All()) = %v, want %v", got, want)
+	}
+
+	var keys, values int
+	for range m.Keys() {
+		keys++
+	}
+	for range m.Values() {
+		values++
+	}
+	if keys != 2 || values != 2 {
+		t.Errorf(
+			"range m.Keys(), m.Values() saw %d, %d entries, want 2, 2",
+			keys, values,
+		)
+	}
+}
+
+func TestSyncMapModifyDuringIteration(t *testing.T) {
+	var m SyncMap[int, int]
+
+	for i := range 3 {
+		m.Set(i, i)
+	}
+
+	var n int
+	for k := range m.All() {
+		m.Delete(k)
+		m.Set(k+100, k)
+		n++
+	}
+
+	if n != 3 {
+		t.Errorf("range m.All() while modifying iterated %d times, want 3", n)
+	}
+	if got := m.Len(); got != 3 {
+		t.Errorf("SyncMap[int, int].Len() = %d, want 3", got)
+	}
+}
+
+func TestSyncMapAllStop(t *testing.T) {
+	var m SyncMap[int, int]
+
+	for i := range 3 {
+		m.Set(i, i)
+	}
+
+	var n int
+	for range m.All() {
+		n++
+		if n >= 2 {
+			break
+		}
+	}
+
+	if n != 2 {
+		t.Errorf("range m.All() with break iterated %d times, want 2", n)
+	}
+}
+
+func TestSyncMapUpdate(t *testing.T) {
+	var m SyncMap[string, int]
+
+	if v, loaded := m.GetOrSet("a", 1); v != 1 || loaded {
+		t.Errorf(
+			"SyncMap[string, int].GetOrSet(\"a\", 1) = %d, %t, want 1, false",
+			v, loaded,
+		)
+	}
+	if prev, loaded := m.Swap("a", 2); prev != 1 || !loaded {
+		t.Errorf(
+			"SyncMap[string, int].Swap(\"a\", 2) = %d, %t, want 1, true",
+			prev, loaded,
+		)
+	}
+	if !m.CompareAndSwap("a", 2, 3) {
+		t.Error("SyncMap[string, int].CompareAndSwap(\"a\", 2, 3) = false")
+	}
+	if v, loaded := m.GetOrCompute("a", func() int { return 0 }); v != 3 ||
+		!loaded {
+		t.Errorf(
+			"SyncMap[string, int].GetOrCompute(\"a\", f) = %d, %t, "+
+				"want 3, true",
+			v, loaded,
+		)
+	}
+
+	m.Update("a", func(int, bool) (int, bool) { return 0, false })
+	if got := m.Len(); got != 0 {
+		t.Errorf("SyncMap[string, int].Len() after Update = %d, want 0", got)
+	}
+}
@@ -0,0 +1,80 @@
+package zeros
+
+import "time"
+
+// Throttle returns a channel that receives the values received from in,
+// released at no more than rate values per second with bursts of up to
+// burst values, using a token bucket. It panics if rate is not positive.
+//
+// The returned channel is closed with in's Err once in is closed.
+func Throttle[T any](in *Chan[T], rate float64, burst int) *Chan[T] {
+	if !(rate > 0) {
+		panic("zeros: Throttle called with non-positive rate")
+	}
+	out := new(Chan[T])
+	go func() {
+		defer func() { out.CloseWithError(in.Err()) }()
+		limit := float64(max(burst, 1))
+		tokens, last := limit, time.Now()
+		refill := func() {
+			now := time.Now()
+			tokens = min(limit, tokens+now.Sub(last).Seconds()*rate)
+			last = now
+		}
+		for v := range in.All() {
+			refill()
+			if tokens < 1 {
+				wait := (1 - tokens) / rate * float64(time.Second)
+				time.Sleep(time.Duration(wait))
+				refill()
+			}
+			tokens--
+			if err := out.CheckSend(v); err != nil {
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// Debounce returns a channel that receives a value from in only once no
+// further value has arrived for d, discarding the values it replaces.
+// When in is closed, any pending value is delivered immediately.
+//
+// The returned channel is closed with in's Err once in is closed.
+func Debounce[T any](in *Chan[T], d time.Duration) *Chan[T] {
+	out := new(Chan[T])
+	go func() {
+		defer func() { out.CloseWithError(in.Err()) }()
+		t := time.NewTimer(d)
+		t.Stop()
+		defer t.Stop()
+		var (
+			last    T
+			pending bool
+		)
+		for {
+			var fire <-chan time.Time
+			if pending {
+				fire = t.C
+			}
+			select {
+			case v, ok := <-in.Chan():
+				if !ok {
+					if pending {
+						_ = out.CheckSend(last)
+					}
+					return
+				}
+				last, pending = v, true
+				t.Reset(d)
+			case <-fire:
+				pending = false
+				if err := out.CheckSend(last); err != nil {
+					return
+				}
+			}
+		}
+	}()
+	return out
+}
@@ -0,0 +1,93 @@
+//go:build go1.25
+
+package zeros
+
+import (
+	"errors"
+	"slices"
+	"testing"
+	"testing/synctest"
+	"time"
+)
+
+func TestThrottle(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var in Chan[int]
+
+		go func() {
+			in.SendAll(slices.Values([]int{1, 2, 3, 4, 5}))
+			in.Close()
+		}()
+
+		start := time.Now()
+		var at []time.Duration
+		for range Throttle(&in, 2, 2).All() {
+			at = append(at, time.Since(start))
+		}
+
+		want := []time.Duration{
+			0, 0, 500 * time.Millisecond, time.Second, 1500 * time.Millisecond,
+		}
+		if !slices.Equal(at, want) {
+			t.Errorf("Throttle(2/s, burst 2) released at %v, want %v", at, want)
+		}
+	})
+}
+
+func TestThrottleErr(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var in Chan[int]
+		errStop := errors.New("stop")
+
+		in.CloseWithError(errStop)
+
+		out := Throttle(&in, 1, 1)
+		if _, ok := out.CheckRecv(); ok {
+			t.Fatal("Throttle() output open after input closed")
+		}
+		if err := out.Err(); err != errStop {
+			t.Errorf("Throttle() output Err() = %v, want %v", err, errStop)
+		}
+	})
+}
+
+func TestDebounce(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var in Chan[int]
+
+		go func() {
+			in.Send(1)
+			in.Send(2)
+			time.Sleep(2 * time.Second)
+			in.Send(3)
+			time.Sleep(500 * time.Millisecond)
+			in.Send(4)
+			time.Sleep(2 * time.Second)
+			in.Send(5)
+			in.Close()
+		}()
+
+		got := slices.Collect(Debounce(&in, time.Second).All())
+		if want := []int{2, 4, 5}; !slices.Equal(got, want) {
+			t.Errorf("Debounce(1s) received %v, want %v", got, want)
+		}
+	})
+}
+
+func TestDebounceQuiet(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var in Chan[int]
+		defer in.Close()
+
+		out := Debounce(&in, time.Second)
+		start := time.Now()
+		in.Send(1)
+
+		if got := out.Recv(); got != 1 {
+			t.Errorf("Debounce(1s) received %d, want 1", got)
+		}
+		if got := time.Since(start); got != time.Second {
+			t.Errorf("Debounce(1s) delivered after %v, want 1s", got)
+		}
+	})
+}
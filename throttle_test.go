@@ -0,0 +1,18 @@
+package zeros
+
+import "testing"
+
+func TestThrottleRate(t *testing.T) {
+	for _, rate := range []float64{0, -1} {
+		func() {
+			var in Chan[int]
+
+			defer func() {
+				if recover() == nil {
+					t.Errorf("Throttle(in, %v, 1) did not panic", rate)
+				}
+			}()
+			Throttle(&in, rate, 1)
+		}()
+	}
+}
@@ -0,0 +1,29 @@
+package zeros
+
+import (
+	"sync"
+	"time"
+)
+
+// timerPool pools stopped timers so that timeouts do not allocate a new
+// timer per call.
+//
+// Pools are kept per value rather than per package because a timer
+// created in one testing/synctest bubble must not be used in another.
+type timerPool struct{ p sync.Pool }
+
+// get returns a timer that fires after d.
+// The timer must be returned with put when no longer needed.
+func (p *timerPool) get(d time.Duration) *time.Timer {
+	if t, ok := p.p.Get().(*time.Timer); ok {
+		t.Reset(d)
+		return t
+	}
+	return time.NewTimer(d)
+}
+
+// put stops t and returns it to the pool.
+func (p *timerPool) put(t *time.Timer) {
+	t.Stop()
+	p.p.Put(t)
+}
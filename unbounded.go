@@ -0,0 +1,139 @@
+package zeros
+
+import "sync"
+
+// UnboundedChan is a zero-valueable channel whose Send never blocks.
+//
+// Sent values are held in an internal queue and forwarded to receivers
+// by a goroutine started on first use. Close stops the goroutine; values
+// sent before Close remain available to receivers.
+type UnboundedChan[T any] struct {
+	once   OnceValue[unbounded[T]]
+	mu     sync.Mutex
+	queue  []T
+	closed bool
+}
+
+type unbounded[T any] struct {
+	out    chan T
+	notify chan struct{} // signaled when the queue grows
+	done   chan struct{} // closed by Close
+}
+
+func (c *UnboundedChan[T]) state() unbounded[T] {
+	return c.once.Do(func() unbounded[T] {
+		s := unbounded[T]{
+			out:    make(chan T),
+			notify: make(chan struct{}, 1),
+			done:   make(chan struct{}),
+		}
+		go c.forward(s)
+		return s
+	})
+}
+
+// forward delivers queued values to s.out until Close is called.
+// A value is removed from the queue only once it has been delivered.
+func (c *UnboundedChan[T]) forward(s unbounded[T]) {
+	defer close(s.out)
+	for {
+		c.mu.Lock()
+		if len(c.queue) == 0 {
+			c.mu.Unlock()
+			select {
+			case <-s.notify:
+				continue
+			case <-s.done:
+				return
+			}
+		}
+		v := c.queue[0]
+		c.mu.Unlock()
+
+		select {
+		case s.out <- v:
+			c.pop()
+		case <-s.done:
+			return
+		}
+	}
+}
+
+// pop removes and returns the value at the front of the queue.
+func (c *UnboundedChan[T]) pop() (T, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var zero T
+	if len(c.queue) == 0 {
+		return zero, false
+	}
+	v := c.queue[0]
+	c.queue[0] = zero
+	c.queue = c.queue[1:]
+	return v, true
+}
+
+// Send queues a value on the channel without blocking.
+// It panics if the channel is closed.
+func (c *UnboundedChan[T]) Send(v T) {
+	s := c.state()
+	c.mu.Lock()
+	if c.closed {
+		c.mu.Unlock()
+		panic("zeros: send on closed UnboundedChan")
+	}
+	c.queue = append(c.queue, v)
+	c.mu.Unlock()
+	select {
+	case s.notify <- struct{}{}:
+	default:
+	}
+}
+
+// Recv receives a value from the channel, blocking until a value is available.
+// Returns the zero value if the channel is closed.
+func (c *UnboundedChan[T]) Recv() T {
+	v, _ := c.CheckRecv()
+	return v
+}
+
+// CheckRecv receives a value from the channel with a status indicator.
+// The boolean return value indicates whether a value was received; it is
+// false only once the channel is closed and all sent values are received.
+func (c *UnboundedChan[T]) CheckRecv() (T, bool) {
+	if v, ok := <-c.state().out; ok {
+		return v, true
+	}
+	return c.pop()
+}
+
+// TryRecv attempts to receive a value from the channel without blocking.
+// If a value is available, it returns the value and true.
+// If no value is available, it returns the zero value and false.
+//
+// A value sent immediately before TryRecv may not yet be available.
+func (c *UnboundedChan[T]) TryRecv() (T, bool) {
+	select {
+	case v, ok := <-c.state().out:
+		if ok {
+			return v, true
+		}
+		return c.pop()
+	default:
+		var zero T
+		return zero, false
+	}
+}
+
+// Close closes the channel and stops its forwarding goroutine.
+// Calls after the first have no effect.
+func (c *UnboundedChan[T]) Close() {
+	s := c.state()
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.closed {
+		return
+	}
+	c.closed = true
+	close(s.done)
+}
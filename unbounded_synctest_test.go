@@ -0,0 +1,68 @@
+//go:build go1.25
+
+package zeros
+
+import (
+	"testing"
+	"testing/synctest"
+)
+
+func TestUnboundedChanZeroValue(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var ch UnboundedChan[int]
+		defer ch.Close()
+
+		ch.Send(1)
+		ch.Send(2)
+
+		if got := ch.Recv(); got != 1 {
+			t.Errorf("UnboundedChan[int].Recv() first call = %d, want 1", got)
+		}
+		if got := ch.Recv(); got != 2 {
+			t.Errorf("UnboundedChan[int].Recv() second call = %d, want 2", got)
+		}
+	})
+}
+
+func TestUnboundedChanTryRecv(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var ch UnboundedChan[int]
+		defer ch.Close()
+
+		if _, ok := ch.TryRecv(); ok {
+			t.Error("UnboundedChan[int].TryRecv() on empty channel = true")
+		}
+
+		ch.Send(42)
+		synctest.Wait()
+
+		if got, ok := ch.TryRecv(); !ok || got != 42 {
+			t.Errorf(
+				"UnboundedChan[int].TryRecv() = %d, %v, want 42, true",
+				got, ok,
+			)
+		}
+	})
+}
+
+func TestUnboundedChanCloseStopsGoroutine(t *testing.T) {
+	synctest.Test(t, func(t *testing.T) {
+		var ch UnboundedChan[int]
+
+		ch.Send(1)
+		ch.Send(2)
+		ch.Close()
+		synctest.Wait()
+
+		// The forwarding goroutine has exited; synctest.Test would
+		// otherwise report a deadlock. Queued values remain receivable.
+		for _, want := range []int{1, 2} {
+			if got := ch.Recv(); got != want {
+				t.Errorf(
+					"UnboundedChan[int].Recv() after Close() = %d, want %d",
+					got, want,
+				)
+			}
+		}
+	})
+}
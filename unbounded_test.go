@@ -0,0 +1,54 @@
+package zeros
+
+import "testing"
+
+func TestUnboundedChanSendNoBlock(t *testing.T) {
+	var ch UnboundedChan[int]
+
+	for i := range 1000 {
+		ch.Send(i)
+	}
+	ch.Close()
+
+	for i := range 1000 {
+		if got, ok := ch.CheckRecv(); !ok || got != i {
+			t.Fatalf(
+				"UnboundedChan[int].CheckRecv() = %d, %v, want %d, true",
+				got, ok, i,
+			)
+		}
+	}
+	if _, ok := ch.CheckRecv(); ok {
+		t.Error(
+			"UnboundedChan[int].CheckRecv() after draining closed channel " +
+				"= true, want false",
+		)
+	}
+}
+
+func TestUnboundedChanClose(t *testing.T) {
+	var ch UnboundedChan[int]
+
+	ch.Close()
+	ch.Close()
+
+	if _, ok := ch.CheckRecv(); ok {
+		t.Error("UnboundedChan[int].CheckRecv() after Close() = true")
+	}
+	if _, ok := ch.TryRecv(); ok {
+		t.Error("UnboundedChan[int].TryRecv() after Close() = true")
+	}
+}
+
+func TestUnboundedChanSendClosed(t *testing.T) {
+	var ch UnboundedChan[int]
+
+	ch.Close()
+
+	defer func() {
+		if recover() == nil {
+			t.Error("UnboundedChan[int].Send() after Close() did not panic")
+		}
+	}()
+	ch.Send(42)
+}